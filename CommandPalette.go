@@ -0,0 +1,297 @@
+package imgui
+
+import (
+	"sort"
+	"strconv"
+)
+
+// Command is an action that can be run from a CommandPalette.
+type Command struct {
+	// Name is displayed in the palette, and identifies the command within its category.
+	Name string
+	// Category groups commands, such as "File". It is displayed before the name.
+	Category string
+	// Shortcut is displayed next to the name, such as "Ctrl+Shift+S". It is not handled by the palette.
+	Shortcut string
+	// Enabled returns whether the command can currently be run. Without function, the command is always enabled.
+	Enabled func() bool
+	// Run performs the command. It is called after the palette was closed.
+	Run func()
+}
+
+// Label returns the text the command is displayed with, and searched by: its category and name.
+func (command Command) Label() string {
+	if command.Category == "" {
+		return command.Name
+	}
+	return command.Category + ": " + command.Name
+}
+
+// IsEnabled returns whether the command can currently be run.
+func (command Command) IsEnabled() bool {
+	return (command.Enabled == nil) || command.Enabled()
+}
+
+// CommandPalette is a modal popup to search for commands by name and run them.
+//
+// The commands are ranked by how well their label matches the entered text with TextMatchModeFuzzy. Without text,
+// the recently run commands are listed first. The arrow keys select a command, Enter or a click runs it, and Escape
+// closes the palette.
+//
+// Usage:
+//   palette := imgui.NewCommandPalette()
+//   palette.Register(imgui.Command{Category: "File", Name: "Save", Shortcut: "Ctrl+S", Run: save})
+//   ...
+//   if openPaletteShortcutPressed {
+//       palette.Open()
+//   }
+//   palette.Render()
+type CommandPalette struct {
+	// Title identifies the popup.
+	Title string
+	// Width is the width of the text input and the list of results.
+	Width float32
+	// VisibleResults is the number of results that are shown without scrolling.
+	VisibleResults int
+	// MaxRecent is the number of recently run commands that are remembered.
+	MaxRecent int
+
+	commands []Command
+	recent   []string
+
+	query         string
+	results       []int
+	selected      int
+	scrollPending bool
+	openRequested bool
+}
+
+// NewCommandPalette returns a palette without commands.
+func NewCommandPalette() *CommandPalette {
+	return &CommandPalette{
+		Title:          "Command Palette",
+		Width:          500,
+		VisibleResults: 10,
+		MaxRecent:      5,
+	}
+}
+
+// Register adds a command to the palette. A previously registered command with the same label is replaced.
+func (palette *CommandPalette) Register(command Command) {
+	label := command.Label()
+	for index := range palette.commands {
+		if palette.commands[index].Label() == label {
+			palette.commands[index] = command
+			palette.updateResults()
+			return
+		}
+	}
+	palette.commands = append(palette.commands, command)
+	palette.updateResults()
+}
+
+// Unregister removes the command with given category and name.
+func (palette *CommandPalette) Unregister(category, name string) {
+	label := Command{Category: category, Name: name}.Label()
+	for index := range palette.commands {
+		if palette.commands[index].Label() == label {
+			palette.commands = append(palette.commands[:index], palette.commands[index+1:]...)
+			palette.updateResults()
+			return
+		}
+	}
+}
+
+// Recent returns the labels of the recently run commands, the most recent first.
+func (palette *CommandPalette) Recent() []string {
+	return append([]string(nil), palette.recent...)
+}
+
+// SetRecent sets the labels of the recently run commands, such as from persisted settings.
+func (palette *CommandPalette) SetRecent(labels []string) {
+	palette.recent = append([]string(nil), labels...)
+	palette.updateResults()
+}
+
+// Open requests to open the palette, with an empty search text, during the next call to Render().
+func (palette *CommandPalette) Open() {
+	palette.openRequested = true
+}
+
+// Results returns the labels of the commands that currently match the search text, the best match first.
+func (palette *CommandPalette) Results() []string {
+	labels := make([]string, len(palette.results))
+	for index, command := range palette.results {
+		labels[index] = palette.commands[command].Label()
+	}
+	return labels
+}
+
+func (palette *CommandPalette) recentRank(label string) int {
+	for index, recent := range palette.recent {
+		if recent == label {
+			return index
+		}
+	}
+	return len(palette.recent)
+}
+
+func (palette *CommandPalette) updateResults() {
+	matcher, _ := NewTextMatcher(palette.query, TextMatchModeFuzzy)
+	scores := make(map[int]int)
+	palette.results = palette.results[:0]
+	for index, command := range palette.commands {
+		if score, pass := matcher.Score(command.Label()); pass {
+			palette.results = append(palette.results, index)
+			scores[index] = score
+		}
+	}
+	sort.SliceStable(palette.results, func(a, b int) bool {
+		commandA, commandB := palette.results[a], palette.results[b]
+		if scores[commandA] != scores[commandB] {
+			return scores[commandA] > scores[commandB]
+		}
+		labelA, labelB := palette.commands[commandA].Label(), palette.commands[commandB].Label()
+		if rankA, rankB := palette.recentRank(labelA), palette.recentRank(labelB); rankA != rankB {
+			return rankA < rankB
+		}
+		return labelA < labelB
+	})
+	palette.selected = 0
+	palette.moveSelection(0)
+}
+
+// moveSelection moves the selection by the given number of results, skipping disabled commands.
+// With a delta of zero, the first enabled command from the current selection on is selected.
+func (palette *CommandPalette) moveSelection(delta int) {
+	step := 1
+	if delta < 0 {
+		step = -1
+	}
+	candidate := palette.selected + delta
+	for (candidate >= 0) && (candidate < len(palette.results)) {
+		if palette.commands[palette.results[candidate]].IsEnabled() {
+			palette.selected = candidate
+			palette.scrollPending = true
+			return
+		}
+		candidate += step
+	}
+}
+
+func (palette *CommandPalette) remember(label string) {
+	recent := []string{label}
+	for _, other := range palette.recent {
+		if (other != label) && (len(recent) < palette.MaxRecent) {
+			recent = append(recent, other)
+		}
+	}
+	palette.recent = recent
+}
+
+// Render displays the palette while it is open. Call it once per frame.
+func (palette *CommandPalette) Render() {
+	if palette.openRequested {
+		palette.openRequested = false
+		palette.query = ""
+		palette.updateResults()
+		OpenPopup(palette.Title)
+	}
+	workPos, workSize := MainViewport().WorkPos(), MainViewport().WorkSize()
+	SetNextWindowPosV(Vec2{X: workPos.X + workSize.X/2, Y: workPos.Y + workSize.Y*0.15}, ConditionAppearing,
+		Vec2{X: 0.5})
+	flags := WindowFlagsNoTitleBar | WindowFlagsNoMove | WindowFlagsAlwaysAutoResize | WindowFlagsNoSavedSettings
+	if !BeginPopupModalV(palette.Title, nil, flags) {
+		return
+	}
+	var run *Command
+
+	if IsWindowAppearing() {
+		SetKeyboardFocusHere()
+	}
+	query := palette.query
+	SetNextItemWidth(palette.Width)
+	entered := InputTextV("##query", &query, InputTextFlagsEnterReturnsTrue|InputTextFlagsCallbackHistory,
+		func(data InputTextCallbackData) int32 {
+			switch data.EventKey() {
+			case KeyUpArrow:
+				palette.moveSelection(-1)
+			case KeyDownArrow:
+				palette.moveSelection(1)
+			}
+			return 0
+		})
+	if query != palette.query {
+		palette.query = query
+		palette.updateResults()
+	}
+	if entered && (palette.selected < len(palette.results)) {
+		if command := palette.commands[palette.results[palette.selected]]; command.IsEnabled() {
+			run = &command
+		}
+	}
+	if entered || IsKeyPressedV(KeyIndex(KeyEscape), false) {
+		CloseCurrentPopup()
+	}
+
+	if clicked := palette.renderResults(); clicked != nil {
+		run = clicked
+		// The results are in a child window, for which the selectable doesn't close the popup.
+		CloseCurrentPopup()
+	}
+	EndPopup()
+
+	if run != nil {
+		palette.remember(run.Label())
+		if run.Run != nil {
+			run.Run()
+		}
+	}
+}
+
+// renderResults lists the results, returning the command that was clicked.
+func (palette *CommandPalette) renderResults() *Command {
+	lineHeight := TextLineHeightWithSpacing()
+	visible := len(palette.results)
+	if visible > palette.VisibleResults {
+		visible = palette.VisibleResults
+	}
+	if visible == 0 {
+		Text("No matching commands")
+		return nil
+	}
+	BeginChildV("##results", Vec2{X: palette.Width, Y: float32(visible) * lineHeight}, false, 0)
+	var clicked *Command
+	disabledColor := CurrentStyle().Color(StyleColorTextDisabled)
+	var clipper ListClipper
+	clipper.Begin(len(palette.results))
+	for clipper.Step() {
+		for index := clipper.DisplayStart; index < clipper.DisplayEnd; index++ {
+			command := palette.commands[palette.results[index]]
+			var flags SelectableFlags
+			if !command.IsEnabled() {
+				flags = SelectableFlagsDisabled
+			}
+			if SelectableV(command.Label()+"##"+strconv.Itoa(index), index == palette.selected, flags, Vec2{}) {
+				clicked = &command
+			}
+			if command.Shortcut != "" {
+				SameLineV(palette.Width-CalcTextSize(command.Shortcut, false, 0).X-CurrentStyle().ItemSpacing().X, -1)
+				PushStyleColor(StyleColorText, disabledColor)
+				Text(command.Shortcut)
+				PopStyleColor()
+			}
+		}
+	}
+	if palette.scrollPending {
+		palette.scrollPending = false
+		itemTop := float32(palette.selected) * lineHeight
+		if itemTop < ScrollY() {
+			SetScrollY(itemTop)
+		} else if itemTop+lineHeight > ScrollY()+float32(visible)*lineHeight {
+			SetScrollY(itemTop + lineHeight - float32(visible)*lineHeight)
+		}
+	}
+	EndChild()
+	return clicked
+}
@@ -0,0 +1,111 @@
+package imgui_test
+
+import (
+	"testing"
+
+	"github.com/ianling/imgui-go"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	paletteTestKeyEnter = iota + 1
+	paletteTestKeyDown
+)
+
+func renderCommandPalette(palette *imgui.CommandPalette, frames int) {
+	for frame := 0; frame < frames; frame++ {
+		renderTestFrame(palette.Render)
+	}
+}
+
+func pressCommandPaletteKey(palette *imgui.CommandPalette, key int) {
+	io := imgui.CurrentIO()
+	io.KeyPress(key)
+	renderCommandPalette(palette, 1)
+	io.KeyRelease(key)
+	renderCommandPalette(palette, 1)
+}
+
+func TestCommandPaletteRanksAndRuns(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 800, Y: 600})
+	defer context.Destroy()
+	io := imgui.CurrentIO()
+	io.KeyMap(imgui.KeyEnter, paletteTestKeyEnter)
+	io.KeyMap(imgui.KeyDownArrow, paletteTestKeyDown)
+
+	var ran []string
+	command := func(category, name string, enabled bool) imgui.Command {
+		return imgui.Command{
+			Category: category,
+			Name:     name,
+			Enabled:  func() bool { return enabled },
+			Run:      func() { ran = append(ran, name) },
+		}
+	}
+	palette := imgui.NewCommandPalette()
+	palette.Register(command("File", "Save", true))
+	palette.Register(command("File", "Save As", false))
+	palette.Register(command("File", "Open", true))
+	palette.Register(command("View", "Toggle Sidebar", true))
+	palette.Register(imgui.Command{Category: "File", Name: "Save All", Shortcut: "Ctrl+Shift+S",
+		Run: func() { ran = append(ran, "Save All") }})
+
+	palette.Open()
+	renderCommandPalette(palette, 2)
+	io.AddInputCharacters("fsa")
+	renderCommandPalette(palette, 1)
+	assert.Equal(t, []string{"File: Save All", "File: Save As", "File: Save"}, palette.Results(),
+		"Matches at word starts should rank first")
+
+	// The disabled "Save As" is skipped.
+	pressCommandPaletteKey(palette, paletteTestKeyDown)
+	pressCommandPaletteKey(palette, paletteTestKeyEnter)
+	assert.Equal(t, []string{"Save"}, ran, "Selected command should run")
+	assert.Equal(t, []string{"File: Save"}, palette.Recent())
+
+	palette.Open()
+	renderCommandPalette(palette, 2)
+	assert.Equal(t, "File: Save", palette.Results()[0], "Recent commands should be listed first")
+	pressCommandPaletteKey(palette, paletteTestKeyEnter)
+	assert.Equal(t, []string{"Save", "Save"}, ran)
+
+	palette.Unregister("View", "Toggle Sidebar")
+	palette.Open()
+	renderCommandPalette(palette, 2)
+	io.AddInputCharacters("sidebar")
+	renderCommandPalette(palette, 1)
+	assert.Empty(t, palette.Results())
+	pressCommandPaletteKey(palette, paletteTestKeyEnter)
+	assert.Len(t, ran, 2, "Nothing should run without result")
+}
+
+func TestCommandPaletteRunsClickedCommand(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 800, Y: 600})
+	defer context.Destroy()
+	io := imgui.CurrentIO()
+
+	var ran []string
+	palette := imgui.NewCommandPalette()
+	for _, name := range []string{"Open", "Save"} {
+		name := name
+		palette.Register(imgui.Command{Category: "File", Name: name, Run: func() { ran = append(ran, name) }})
+	}
+	palette.Open()
+	renderCommandPalette(palette, 2)
+
+	// The results are listed below the query input, the second one is "File: Save".
+	io.SetMousePosition(imgui.Vec2{X: 400, Y: 146})
+	for _, down := range []bool{false, true, false} {
+		io.SetMouseButtonDown(0, down)
+		renderCommandPalette(palette, 1)
+	}
+	assert.Equal(t, []string{"Save"}, ran, "Clicked command should run")
+
+	var open bool
+	renderTestFrame(func() {
+		palette.Render()
+		open = imgui.IsPopupOpen(palette.Title)
+	})
+	assert.False(t, open, "Palette should be closed after a click")
+}
@@ -0,0 +1,316 @@
+package imgui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DatePickerConfig configures the date and time picker widgets.
+// The zero value is a valid configuration without limits, starting weeks on Sunday.
+type DatePickerConfig struct {
+	// Min is the earliest value that can be picked. The zero time does not limit the value.
+	Min time.Time
+	// Max is the latest value that can be picked. The zero time does not limit the value.
+	Max time.Time
+	// WeekStart is the day shown in the first column of the calendar.
+	WeekStart time.Weekday
+	// Layout is used to display and enter the value, as for time.Format(). An empty layout selects
+	// "2006-01-02" for dates, "15:04:05" for times, and "2006-01-02 15:04:05" for both.
+	Layout string
+	// HideTimeZone hides the time zone of the value, which TimePicker and DateTimePicker otherwise display.
+	HideTimeZone bool
+}
+
+// clamp returns the given time, limited to Min and Max.
+func (config DatePickerConfig) clamp(t time.Time) time.Time {
+	if !config.Min.IsZero() && t.Before(config.Min) {
+		return config.Min.In(t.Location())
+	}
+	if !config.Max.IsZero() && t.After(config.Max) {
+		return config.Max.In(t.Location())
+	}
+	return t
+}
+
+type pickerMode int
+
+const (
+	pickerModeDate pickerMode = 1 << iota
+	pickerModeTime
+)
+
+// pickerArrowDown is the direction of the button that opens the popup, the value of ImGuiDir_Down.
+const pickerArrowDown uint8 = 3
+
+const pickerPopupID = "##picker"
+
+// pickerState is kept for a picker while its text is edited, or its popup is open.
+type pickerState struct {
+	editing bool
+	text    string
+	err     error
+	month   time.Time
+}
+
+var pickerStates = struct {
+	mutex  sync.Mutex
+	states map[ID]*pickerState
+}{states: make(map[ID]*pickerState)}
+
+func pickerStateFor(id ID) *pickerState {
+	pickerStates.mutex.Lock()
+	defer pickerStates.mutex.Unlock()
+	state, known := pickerStates.states[id]
+	if !known {
+		state = &pickerState{}
+	}
+	return state
+}
+
+func keepPickerState(id ID, state *pickerState, keep bool) {
+	pickerStates.mutex.Lock()
+	defer pickerStates.mutex.Unlock()
+	if keep {
+		pickerStates.states[id] = state
+	} else {
+		delete(pickerStates.states, id)
+	}
+}
+
+// DatePicker calls DatePickerV(label, value, DatePickerConfig{}).
+func DatePicker(label string, value *time.Time) bool {
+	return DatePickerV(label, value, DatePickerConfig{})
+}
+
+// DatePickerV creates a widget to edit the date of given time, keeping its time of day and location.
+// The date can be entered as text according to the layout of the config, or picked from a calendar popup.
+// Text that can not be parsed, or is outside of the limits of the config, is shown with an error and is not applied.
+// It returns true if the value was changed.
+func DatePickerV(label string, value *time.Time, config DatePickerConfig) bool {
+	return pickTime(label, value, config, pickerModeDate, "2006-01-02")
+}
+
+// TimePicker calls TimePickerV(label, value, DatePickerConfig{}).
+func TimePicker(label string, value *time.Time) bool {
+	return TimePickerV(label, value, DatePickerConfig{})
+}
+
+// TimePickerV creates a widget to edit the time of day of given time, keeping its date and location.
+// The time can be entered as text according to the layout of the config, or picked with sliders in a popup.
+// It returns true if the value was changed.
+func TimePickerV(label string, value *time.Time, config DatePickerConfig) bool {
+	return pickTime(label, value, config, pickerModeTime, "15:04:05")
+}
+
+// DateTimePicker calls DateTimePickerV(label, value, DatePickerConfig{}).
+func DateTimePicker(label string, value *time.Time) bool {
+	return DateTimePickerV(label, value, DatePickerConfig{})
+}
+
+// DateTimePickerV creates a widget to edit date and time of day of given time, keeping its location.
+// It combines DatePickerV() and TimePickerV() with a popup containing both the calendar and the sliders.
+// It returns true if the value was changed.
+//
+// Usage:
+//   config := imgui.DatePickerConfig{Min: time.Now(), WeekStart: time.Monday}
+//   if imgui.DateTimePickerV("Appointment", &appointment, config) {
+//       ...
+//   }
+func DateTimePickerV(label string, value *time.Time, config DatePickerConfig) bool {
+	return pickTime(label, value, config, pickerModeDate|pickerModeTime, "2006-01-02 15:04:05")
+}
+
+func pickTime(label string, value *time.Time, config DatePickerConfig, mode pickerMode, layout string) bool {
+	if config.Layout != "" {
+		layout = config.Layout
+	}
+	PushID(label)
+	defer PopID()
+	id := GetID(pickerPopupID)
+	state := pickerStateFor(id)
+	changed := false
+	spacing := CurrentStyle().ItemInnerSpacing().X
+
+	text := value.Format(layout)
+	if state.editing {
+		text = state.text
+	}
+	if state.err != nil {
+		PushStyleColor(StyleColorFrameBg, Vec4{X: 0.6, Y: 0.15, Z: 0.15, W: 1})
+	}
+	SetNextItemWidth(CalcItemWidth() - FrameHeight() - spacing)
+	InputTextV("##text", &text, InputTextFlagsAutoSelectAll, nil)
+	if state.err != nil {
+		PopStyleColor()
+		if IsItemHovered() {
+			SetTooltip(state.err.Error())
+		}
+	}
+	if IsItemActive() {
+		state.editing = true
+		state.text = text
+		_, state.err = parsePickedTime(text, layout, *value, mode, config)
+	} else if state.editing {
+		if parsed, err := parsePickedTime(text, layout, *value, mode, config); (err == nil) && !parsed.Equal(*value) {
+			*value = parsed
+			changed = true
+		}
+		state.editing = false
+		state.err = nil
+	}
+
+	SameLineV(0, spacing)
+	if ArrowButton("##open", pickerArrowDown) {
+		state.month = pickerMonthOf(*value)
+		OpenPopup(pickerPopupID)
+	}
+	if ((mode & pickerModeTime) != 0) && !config.HideTimeZone {
+		SameLineV(0, spacing)
+		AlignTextToFramePadding()
+		Text(value.Format("MST"))
+	}
+	if visibleLabel := strings.SplitN(label, "##", 2)[0]; visibleLabel != "" {
+		SameLineV(0, spacing)
+		AlignTextToFramePadding()
+		Text(visibleLabel)
+	}
+
+	popupOpen := BeginPopup(pickerPopupID)
+	if popupOpen {
+		if (mode & pickerModeDate) != 0 {
+			changed = state.renderCalendar(value, config, mode) || changed
+		}
+		if (mode & pickerModeTime) != 0 {
+			changed = renderTimeSliders(value, config) || changed
+		}
+		EndPopup()
+	}
+	keepPickerState(id, state, state.editing || popupOpen)
+	return changed
+}
+
+// parsePickedTime parses the text and takes the fields of the mode from it, the others from the current value.
+func parsePickedTime(text, layout string, value time.Time, mode pickerMode, config DatePickerConfig) (time.Time, error) {
+	parsed, err := time.ParseInLocation(layout, strings.TrimSpace(text), value.Location())
+	if err != nil {
+		return value, err
+	}
+	year, month, day := value.Date()
+	hour, minute, second := value.Clock()
+	nanosecond := value.Nanosecond()
+	if (mode & pickerModeDate) != 0 {
+		year, month, day = parsed.Date()
+	}
+	if (mode & pickerModeTime) != 0 {
+		hour, minute, second = parsed.Clock()
+		nanosecond = parsed.Nanosecond()
+	}
+	picked := time.Date(year, month, day, hour, minute, second, nanosecond, value.Location())
+	if !config.Min.IsZero() && picked.Before(config.Min) {
+		return value, fmt.Errorf("%s is before %s", text, config.Min.In(value.Location()).Format(layout))
+	}
+	if !config.Max.IsZero() && picked.After(config.Max) {
+		return value, fmt.Errorf("%s is after %s", text, config.Max.In(value.Location()).Format(layout))
+	}
+	return picked, nil
+}
+
+func pickerMonthOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
+
+// renderCalendar renders the month navigation and the days of the current month.
+func (state *pickerState) renderCalendar(value *time.Time, config DatePickerConfig, mode pickerMode) bool {
+	if Button("<<") {
+		state.month = state.month.AddDate(-1, 0, 0)
+	}
+	SameLine()
+	if Button("<") {
+		state.month = state.month.AddDate(0, -1, 0)
+	}
+	SameLine()
+	AlignTextToFramePadding()
+	Text(state.month.Format("January 2006"))
+	SameLine()
+	if Button(">") {
+		state.month = state.month.AddDate(0, 1, 0)
+	}
+	SameLine()
+	if Button(">>") {
+		state.month = state.month.AddDate(1, 0, 0)
+	}
+
+	const daysPerWeek = 7
+	if !BeginTableV("##days", daysPerWeek, TableFlagsSizingFixedSame, Vec2{}, 0) {
+		return false
+	}
+	for column := 0; column < daysPerWeek; column++ {
+		weekday := time.Weekday((int(config.WeekStart) + column) % daysPerWeek)
+		TableSetupColumn(weekday.String()[:2])
+	}
+	TableHeadersRow()
+
+	var selectFlags SelectableFlags
+	if (mode & pickerModeTime) != 0 {
+		selectFlags = SelectableFlagsDontClosePopups
+	}
+	month := state.month
+	leading := (int(month.Weekday()) - int(config.WeekStart) + daysPerWeek) % daysPerWeek
+	first := month.AddDate(0, 0, -leading)
+	hour, minute, second := value.Clock()
+	changed := false
+	for cell := 0; cell < 6*daysPerWeek; cell++ {
+		TableNextColumn()
+		day := first.AddDate(0, 0, cell)
+		dayStart := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, value.Location())
+		flags := selectFlags
+		if (!config.Max.IsZero() && dayStart.After(config.Max)) ||
+			(!config.Min.IsZero() && !dayStart.AddDate(0, 0, 1).After(config.Min)) {
+			flags |= SelectableFlagsDisabled
+		}
+		otherMonth := day.Month() != month.Month()
+		if otherMonth {
+			PushStyleColor(StyleColorText, CurrentStyle().Color(StyleColorTextDisabled))
+		}
+		selected := (day.Year() == value.Year()) && (day.YearDay() == value.YearDay())
+		if SelectableV(strconv.Itoa(day.Day())+"##"+strconv.Itoa(cell), selected, flags, Vec2{}) {
+			picked := config.clamp(time.Date(day.Year(), day.Month(), day.Day(),
+				hour, minute, second, value.Nanosecond(), value.Location()))
+			if !picked.Equal(*value) {
+				*value = picked
+				changed = true
+			}
+			state.month = pickerMonthOf(picked)
+		}
+		if otherMonth {
+			PopStyleColor()
+		}
+	}
+	EndTable()
+	return changed
+}
+
+// renderTimeSliders renders sliders for hour, minute and second of the value.
+func renderTimeSliders(value *time.Time, config DatePickerConfig) bool {
+	hour, minute, second := value.Clock()
+	clock := [3]int32{int32(hour), int32(minute), int32(second)}
+	PushItemWidth(CalcTextSize("00:00:00", false, 0).X * 3)
+	changed := SliderIntV("Hour", &clock[0], 0, 23, "%02d", SliderFlagsAlwaysClamp)
+	changed = SliderIntV("Minute", &clock[1], 0, 59, "%02d", SliderFlagsAlwaysClamp) || changed
+	changed = SliderIntV("Second", &clock[2], 0, 59, "%02d", SliderFlagsAlwaysClamp) || changed
+	PopItemWidth()
+	if !changed {
+		return false
+	}
+	year, month, day := value.Date()
+	picked := config.clamp(time.Date(year, month, day, int(clock[0]), int(clock[1]), int(clock[2]),
+		value.Nanosecond(), value.Location()))
+	if picked.Equal(*value) {
+		return false
+	}
+	*value = picked
+	return true
+}
@@ -0,0 +1,74 @@
+package imgui_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ianling/imgui-go"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func renderPickerFrames(frames int, render func(frame int)) {
+	for frame := 0; frame < frames; frame++ {
+		renderTestWindow(func() { render(frame) })
+	}
+}
+
+func TestDatePickerKeyboardEntry(t *testing.T) {
+	tt := []struct {
+		name     string
+		text     string
+		expected time.Time
+		changes  int
+	}{
+		{name: "valid", text: "2024-02-29", expected: time.Date(2024, time.February, 29, 10, 30, 0, 0, time.UTC), changes: 1},
+		{name: "invalid", text: "2024-02-30", expected: time.Date(2023, time.May, 17, 10, 30, 0, 0, time.UTC)},
+		{name: "before minimum", text: "1999-12-31", expected: time.Date(2023, time.May, 17, 10, 30, 0, 0, time.UTC)},
+	}
+	for _, tc := range tt {
+		td := tc
+		t.Run(td.name, func(t *testing.T) {
+			context := newTestContext(imgui.Vec2{X: 600, Y: 400})
+			defer context.Destroy()
+			io := imgui.CurrentIO()
+
+			value := time.Date(2023, time.May, 17, 10, 30, 0, 0, time.UTC)
+			config := imgui.DatePickerConfig{Min: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)}
+			changes := 0
+			renderPickerFrames(5, func(frame int) {
+				switch frame {
+				case 1:
+					io.SetMousePosition(imgui.Vec2{X: 20, Y: 15})
+					io.SetMouseButtonDown(0, true)
+				case 2:
+					io.SetMouseButtonDown(0, false)
+					io.AddInputCharacters(td.text)
+				case 3:
+					io.SetMousePosition(imgui.Vec2{X: 500, Y: 300})
+					io.SetMouseButtonDown(0, true)
+				}
+				if imgui.DatePickerV("Date", &value, config) {
+					changes++
+				}
+			})
+
+			assert.Equal(t, td.expected, value)
+			assert.Equal(t, td.changes, changes)
+		})
+	}
+}
+
+func TestPickersRender(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 600, Y: 400})
+	defer context.Destroy()
+
+	value := time.Date(2023, time.May, 17, 10, 30, 0, 0, time.UTC)
+	config := imgui.DatePickerConfig{WeekStart: time.Monday, HideTimeZone: true}
+	renderPickerFrames(3, func(frame int) {
+		imgui.TimePicker("Time", &value)
+		imgui.DateTimePickerV("Both", &value, config)
+		imgui.DatePicker("##hidden", &value)
+	})
+	assert.Equal(t, time.Date(2023, time.May, 17, 10, 30, 0, 0, time.UTC), value)
+}
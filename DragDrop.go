@@ -2,6 +2,7 @@ package imgui
 
 // #include "wrapper/DragDrop.h"
 import "C"
+import "strings"
 
 // DragDropFlags for BeginDragDropSource(), etc.
 type DragDropFlags int
@@ -81,3 +82,110 @@ func AcceptDragDropPayload(dataType string, flags DragDropFlags) []byte {
 func EndDragDropTarget() {
 	C.iggEndDragDropTarget()
 }
+
+// DragDropPayloadTypeFiles is the payload type of file names dropped onto the application from outside.
+// It is reserved by this wrapper; use AcceptDragDropFiles() to receive such a payload.
+const DragDropPayloadTypeFiles = "_EXTERN_FILES"
+
+// externDropFrames is the number of frames an external drop is offered for:
+// The first frame lets a target accept the payload, the second frame delivers it.
+// If the files were dragged over the application before, a target under the mouse accepted the payload already,
+// and the first frame delivers it.
+const externDropFrames = 2
+
+var externDrop struct {
+	payload  []byte
+	frames   int
+	dragging bool
+}
+
+// BeginExternFilesDrag reports that files are dragged over the application from outside, before they are dropped.
+// It is typically called by the platform when such a drag enters the window; the platform then keeps updating
+// the mouse position while the files are dragged over the window. The drag ends with DropExternFiles(),
+// or with CancelExternFilesDrag() if the files leave the window again.
+//
+// While the drag is in progress, an external source with the payload type DragDropPayloadTypeFiles is active,
+// so drag and drop targets under the mouse are highlighted as for drags within imgui. The file names are not
+// known before the drop, so AcceptDragDropFiles() returns nil until then. As during drags within imgui,
+// the left mouse button is held and the source is the active item, so that the widgets under the mouse
+// are neither clicked by the drag nor by the drop.
+func BeginExternFilesDrag() {
+	externDrop.dragging = true
+}
+
+// CancelExternFilesDrag ends a drag started with BeginExternFilesDrag() without dropping files.
+func CancelExternFilesDrag() {
+	endExternFilesDrag()
+}
+
+// endExternFilesDrag ends a drag in progress, returning false if there was none.
+func endExternFilesDrag() bool {
+	if !externDrop.dragging {
+		return false
+	}
+	externDrop.dragging = false
+	CurrentIO().SetMouseButtonDown(0, false)
+	return true
+}
+
+// DropExternFiles starts a drag'n'drop operation for file names that were dropped onto the application from
+// outside, such as from a file manager. It is typically called by the platform from its drop callback.
+//
+// The files are offered as an external source with the payload type DragDropPayloadTypeFiles during the next
+// frames, at the current mouse position. Any drag and drop target under the mouse can then accept them
+// with AcceptDragDropFiles(), which also draws the default highlight rectangle around the hovered target.
+// A drag started with BeginExternFilesDrag() ends with the drop.
+func DropExternFiles(names []string) {
+	dragged := endExternFilesDrag()
+	if len(names) == 0 {
+		return
+	}
+	externDrop.payload = []byte(strings.Join(names, "\x00"))
+	externDrop.frames = externDropFrames
+	if dragged {
+		externDrop.frames = 1
+	}
+}
+
+// holdExternDragMouseButton reports the left mouse button as held down while files are dragged over
+// the application. It is called before a new frame, after the platform updated the mouse state.
+func holdExternDragMouseButton() {
+	if externDrop.dragging {
+		CurrentIO().SetMouseButtonDown(0, true)
+	}
+}
+
+// submitExternDrop registers the external drag or pending drop, if any, as drag and drop source of the current frame.
+func submitExternDrop() {
+	if externDrop.dragging {
+		C.iggKeepExternDragDropSourceActive()
+		submitExternDragDropSource(nil)
+		return
+	}
+	if externDrop.frames <= 0 {
+		return
+	}
+	externDrop.frames--
+	submitExternDragDropSource(externDrop.payload)
+	if externDrop.frames == 0 {
+		externDrop.payload = nil
+	}
+}
+
+func submitExternDragDropSource(payload []byte) {
+	if BeginDragDropSource(DragDropFlagsSourceExtern | DragDropFlagsSourceNoPreviewTooltip) {
+		SetDragDropPayload(DragDropPayloadTypeFiles, payload, ConditionAlways)
+		EndDragDropSource()
+	}
+}
+
+// AcceptDragDropFiles accepts file names dropped onto the current target from outside the application.
+// It returns nil if no such payload is delivered, including while the files are still dragged.
+// See DropExternFiles() for how the file names are provided.
+func AcceptDragDropFiles(flags DragDropFlags) []string {
+	data := AcceptDragDropPayload(DragDropPayloadTypeFiles, flags)
+	if len(data) == 0 {
+		return nil
+	}
+	return strings.Split(string(data), "\x00")
+}
@@ -0,0 +1,91 @@
+package imgui_test
+
+import (
+	"testing"
+
+	"github.com/ianling/imgui-go"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDropExternFilesIsDeliveredToHoveredTarget(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 800, Y: 600})
+	defer context.Destroy()
+	imgui.CurrentIO().SetMousePosition(imgui.Vec2{X: 100, Y: 100})
+
+	var received [][]string
+	frame := func() {
+		renderTestWindow(func() {
+			imgui.ButtonV("Target", imgui.Vec2{X: 300, Y: 300})
+			if imgui.BeginDragDropTarget() {
+				if names := imgui.AcceptDragDropFiles(imgui.DragDropFlagsNone); names != nil {
+					received = append(received, names)
+				}
+				imgui.EndDragDropTarget()
+			}
+		})
+	}
+
+	frame()
+	imgui.DropExternFiles([]string{"/tmp/a.txt", "/tmp/b.png"})
+	for i := 0; i < 4; i++ {
+		frame()
+	}
+
+	assert.Equal(t, [][]string{{"/tmp/a.txt", "/tmp/b.png"}}, received, "Files should be delivered exactly once")
+}
+
+func TestExternFilesDragHighlightsTargetsUntilDrop(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 800, Y: 600})
+	defer context.Destroy()
+	io := imgui.CurrentIO()
+	io.SetMousePosition(imgui.Vec2{X: 100, Y: 100})
+
+	var received [][]string
+	targeted, clicked := 0, 0
+	frame := func() {
+		renderTestWindow(func() {
+			if imgui.ButtonV("Target", imgui.Vec2{X: 300, Y: 300}) {
+				clicked++
+			}
+			if imgui.BeginDragDropTarget() {
+				targeted++
+				if names := imgui.AcceptDragDropFiles(imgui.DragDropFlagsNone); names != nil {
+					received = append(received, names)
+				}
+				imgui.EndDragDropTarget()
+			}
+		})
+	}
+
+	frame()
+	imgui.BeginExternFilesDrag()
+	for i := 0; i < 3; i++ {
+		frame()
+	}
+	assert.Equal(t, 3, targeted, "Target should be hovered while the files are dragged")
+	assert.Empty(t, received, "Files should not be delivered before the drop")
+
+	imgui.DropExternFiles([]string{"/tmp/a.txt"})
+	frame()
+	assert.Equal(t, [][]string{{"/tmp/a.txt"}}, received, "Files should be delivered with the first frame")
+	for i := 0; i < 3; i++ {
+		frame()
+	}
+	assert.Len(t, received, 1, "Files should be delivered exactly once")
+
+	imgui.BeginExternFilesDrag()
+	frame()
+	imgui.CancelExternFilesDrag()
+	for i := 0; i < 3; i++ {
+		frame()
+	}
+	assert.Len(t, received, 1, "Canceled drag should deliver nothing")
+	assert.Equal(t, 0, clicked, "Drag and drop should not click the target")
+
+	for _, down := range []bool{true, false} {
+		io.SetMouseButtonDown(0, down)
+		frame()
+	}
+	assert.Equal(t, 1, clicked, "Target should be clickable after the drag")
+}
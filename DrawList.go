@@ -284,7 +284,7 @@ func (list DrawList) AddQuadFilled(p1, p2, p3, p4 Vec2, col PackedColor) {
 
 // AddText adds a text in specified color at given position pos.
 func (list DrawList) AddText(pos Vec2, col PackedColor, text string) {
-	CString := newStringBuffer(text)
+	CString := newStringBuffer(submittedText(text))
 	defer CString.free()
 	posArg, _ := pos.wrapped()
 	C.iggAddText(list.handle(), posArg, C.IggPackedColor(col), (*C.char)(CString.ptr), C.int(CString.size)-1)
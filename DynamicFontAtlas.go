@@ -0,0 +1,205 @@
+package imgui
+
+import (
+	"sync"
+	"unicode/utf8"
+)
+
+// FontTextureReloader is implemented by renderers that can upload the texture of a font atlas again,
+// after it was rebuilt between frames.
+type FontTextureReloader interface {
+	// ReloadFontTexture releases the current font texture, uploads the texture data of the font atlas
+	// and sets the new texture ID in the atlas.
+	ReloadFontTexture()
+}
+
+// trackingFontAtlas is the atlas that collects the characters of submitted text, if any.
+var trackingFontAtlas *DynamicFontAtlas
+
+// DynamicFontAtlas loads glyphs of fonts lazily, only for the characters that are actually displayed.
+//
+// Fonts that are added through the DynamicFontAtlas start with a small set of glyph ranges. Characters of submitted
+// text that are not within these ranges are collected, and Update() adds them to the ranges of all its fonts and
+// rebuilds the atlas. This allows to support large character sets, such as those of CJK languages, without baking
+// tens of thousands of glyphs into a huge texture.
+//
+// Once TrackSubmittedText() was called, text is collected from Text(), LabelText(), BulletText(), DrawList.AddText(),
+// tooltips, the text and hint of input fields, and the visible part of widget labels and window titles, before a "##".
+// Other text, such as that of custom drawn widgets, has to be added explicitly with AddText(). Characters that first appear in a frame are displayed with the fallback character
+// for that frame, and with their glyph from the next frame on.
+//
+// Usage:
+//   dynamic := imgui.NewDynamicFontAtlas(io.Fonts(), io.Fonts().GlyphRangesDefault())
+//   dynamic.AddFontFromFileTTFV("NotoSansCJK.ttc", 16, imgui.DefaultFontConfig)
+//   dynamic.TrackSubmittedText()
+//   renderer, _ := imgui.NewOpenGL3(io, 1)
+//   for !platform.ShouldStop() {
+//       dynamic.Update(renderer)
+//       platform.NewFrame()
+//       imgui.NewFrame()
+//       ...
+//   }
+type DynamicFontAtlas struct {
+	atlas   FontAtlas
+	builder FontGlyphRangesBuilder
+	ranges  GlyphRanges
+	configs []int
+
+	mutex   sync.Mutex
+	known   map[rune]bool
+	pending []rune
+}
+
+// NewDynamicFontAtlas returns a dynamic atlas for given atlas, with the initial glyph ranges all fonts start with.
+// EmptyGlyphRanges starts with the default ranges of the atlas.
+func NewDynamicFontAtlas(atlas FontAtlas, initialRanges GlyphRanges) *DynamicFontAtlas {
+	if initialRanges == EmptyGlyphRanges {
+		initialRanges = atlas.GlyphRangesDefault()
+	}
+	dynamic := &DynamicFontAtlas{
+		atlas:   atlas,
+		builder: NewFontGlyphRangesBuilder(),
+		known:   make(map[rune]bool),
+	}
+	dynamic.builder.AddRanges(initialRanges)
+	dynamic.ranges = NewGlyphRanges()
+	dynamic.builder.BuildRanges(dynamic.ranges)
+	return dynamic
+}
+
+// Atlas returns the font atlas the fonts are added to.
+func (dynamic *DynamicFontAtlas) Atlas() FontAtlas {
+	return dynamic.atlas
+}
+
+// AddFontDefaultV adds the default font to the atlas, loading its glyphs lazily.
+func (dynamic *DynamicFontAtlas) AddFontDefaultV(config FontConfig) Font {
+	return dynamic.addFont(func() Font {
+		return dynamic.atlas.AddFontDefaultV(config)
+	})
+}
+
+// AddFontFromFileTTFV adds a font from given TTF file to the atlas, loading its glyphs lazily.
+// The config may set merge mode to merge the font into the previously added one.
+func (dynamic *DynamicFontAtlas) AddFontFromFileTTFV(filename string, sizePixels float32, config FontConfig) Font {
+	return dynamic.addFont(func() Font {
+		return dynamic.atlas.AddFontFromFileTTFV(filename, sizePixels, config, dynamic.ranges.Data())
+	})
+}
+
+// AddFontFromMemoryTTFV adds a font from given TTF data to the atlas, loading its glyphs lazily.
+// The config may set merge mode to merge the font into the previously added one.
+func (dynamic *DynamicFontAtlas) AddFontFromMemoryTTFV(fontData []byte, sizePixels float32, config FontConfig) Font {
+	return dynamic.addFont(func() Font {
+		return dynamic.atlas.AddFontFromMemoryTTFV(fontData, sizePixels, config, dynamic.ranges.Data())
+	})
+}
+
+func (dynamic *DynamicFontAtlas) addFont(add func() Font) Font {
+	first := dynamic.atlas.configCount()
+	font := add()
+	for index := first; index < dynamic.atlas.configCount(); index++ {
+		dynamic.atlas.setConfigGlyphRanges(index, dynamic.ranges.Data())
+		dynamic.configs = append(dynamic.configs, index)
+	}
+	return font
+}
+
+// TrackSubmittedText collects the characters of text that is displayed from now on, as described for the type.
+// Only one DynamicFontAtlas can track submitted text; the last one to call this function does.
+func (dynamic *DynamicFontAtlas) TrackSubmittedText() {
+	trackingFontAtlas = dynamic
+}
+
+// StopTracking stops collecting the characters of submitted text, if this atlas is tracking them.
+func (dynamic *DynamicFontAtlas) StopTracking() {
+	if trackingFontAtlas == dynamic {
+		trackingFontAtlas = nil
+	}
+}
+
+// observeSubmittedText passes the text on to the atlas that tracks submitted text, if any.
+func observeSubmittedText(text string) {
+	if trackingFontAtlas != nil {
+		trackingFontAtlas.AddText(text)
+	}
+}
+
+// submittedLabel returns the label in the form it is displayed, as with shapeLabel(), and observes its visible part.
+func submittedLabel(label string) string {
+	shaped, visible := shapeLabel(label)
+	observeSubmittedText(visible)
+	return shaped
+}
+
+// submittedText returns the text in the form it is displayed, as with ShapeText(), and observes it.
+func submittedText(text string) string {
+	shaped := ShapeText(text)
+	observeSubmittedText(shaped)
+	return shaped
+}
+
+// AddText requests the glyphs for all characters of the text to be loaded with the next Update().
+// It is safe to call this function from any goroutine, for example to prepare text before it is displayed.
+func (dynamic *DynamicFontAtlas) AddText(text string) {
+	ascii := true
+	for i := 0; (i < len(text)) && ascii; i++ {
+		ascii = text[i] < utf8.RuneSelf
+	}
+	if ascii {
+		return
+	}
+
+	dynamic.mutex.Lock()
+	defer dynamic.mutex.Unlock()
+	for _, c := range text {
+		if (c < utf8.RuneSelf) || (c == utf8.RuneError) || (c > UnicodeCodepointMax) {
+			continue
+		}
+		if _, known := dynamic.known[c]; known {
+			continue
+		}
+		dynamic.known[c] = true
+		if !dynamic.builder.HasChar(c) {
+			dynamic.pending = append(dynamic.pending, c)
+		}
+	}
+}
+
+// HasPendingGlyphs returns true if characters were collected that are not loaded yet.
+func (dynamic *DynamicFontAtlas) HasPendingGlyphs() bool {
+	dynamic.mutex.Lock()
+	defer dynamic.mutex.Unlock()
+	return len(dynamic.pending) > 0
+}
+
+// Update adds all collected characters to the glyph ranges of the fonts and rebuilds the atlas.
+// If the atlas was rebuilt, the font texture is uploaded again with the given renderer, which may be nil
+// if the caller does this itself. Rasterizers added to the renderer, such as FontAtlasImages, are applied again
+// before the upload; callers without a renderer have to call them after Update().
+// Update must be called between frames, before NewFrame(). It returns true if the atlas was rebuilt.
+func (dynamic *DynamicFontAtlas) Update(renderer FontTextureReloader) bool {
+	dynamic.mutex.Lock()
+	if len(dynamic.pending) == 0 {
+		dynamic.mutex.Unlock()
+		return false
+	}
+	for _, c := range dynamic.pending {
+		dynamic.builder.AddChar(c)
+	}
+	dynamic.pending = nil
+	ranges := NewGlyphRanges()
+	dynamic.builder.BuildRanges(ranges)
+	dynamic.mutex.Unlock()
+	for _, index := range dynamic.configs {
+		dynamic.atlas.setConfigGlyphRanges(index, ranges.Data())
+	}
+	dynamic.ranges.Delete()
+	dynamic.ranges = ranges
+
+	dynamic.atlas.Build()
+	if renderer != nil {
+		renderer.ReloadFontTexture()
+	}
+	return true
+}
@@ -0,0 +1,104 @@
+package imgui_test
+
+import (
+	"testing"
+
+	"github.com/ianling/imgui-go"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type countingFontTextureReloader struct {
+	reloads int
+}
+
+func (reloader *countingFontTextureReloader) ReloadFontTexture() {
+	reloader.reloads++
+}
+
+func TestDynamicFontAtlasLoadsSubmittedCharacters(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+	io := imgui.CurrentIO()
+	io.SetIniFilename("")
+	io.SetDisplaySize(imgui.Vec2{X: 800, Y: 600})
+
+	basicLatin := imgui.NewFontGlyphRangesBuilder()
+	basicLatin.AddRange(0x20, 0x7E)
+	initialRanges := imgui.NewGlyphRanges()
+	basicLatin.BuildRanges(initialRanges)
+
+	dynamic := imgui.NewDynamicFontAtlas(io.Fonts(), initialRanges.Data())
+	font := dynamic.AddFontDefaultV(imgui.DefaultFontConfig)
+	dynamic.TrackSubmittedText()
+	defer dynamic.StopTracking()
+	io.Fonts().TextureDataRGBA32()
+
+	_, found := font.FindGlyphNoFallback('é')
+	assert.False(t, found, "Glyph should not be loaded initially")
+
+	var reloader countingFontTextureReloader
+	frame := func() {
+		dynamic.Update(&reloader)
+		renderTestFrame(func() { imgui.Text("café") })
+	}
+	frame()
+	assert.True(t, dynamic.HasPendingGlyphs())
+	frame()
+	frame()
+
+	_, found = font.FindGlyphNoFallback('é')
+	assert.True(t, found, "Glyph should be loaded after update")
+	assert.Equal(t, 1, reloader.reloads, "Texture should be reloaded once")
+	assert.False(t, dynamic.HasPendingGlyphs())
+}
+
+func TestDynamicFontAtlasIgnoresUnsupportedCharacters(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+
+	dynamic := imgui.NewDynamicFontAtlas(imgui.CurrentIO().Fonts(), imgui.EmptyGlyphRanges)
+	dynamic.AddText("\U0001F600")
+	assert.Equal(t, imgui.UnicodeCodepointMax > 0xFFFF, dynamic.HasPendingGlyphs(),
+		"Characters above the supported maximum should be ignored")
+}
+
+func TestDynamicFontAtlasTracksDisplayedText(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+	io := imgui.CurrentIO()
+	io.SetIniFilename("")
+	io.SetDisplaySize(imgui.Vec2{X: 800, Y: 600})
+
+	basicLatin := imgui.NewFontGlyphRangesBuilder()
+	basicLatin.AddRange(0x20, 0x7E)
+	initialRanges := imgui.NewGlyphRanges()
+	basicLatin.BuildRanges(initialRanges)
+
+	dynamic := imgui.NewDynamicFontAtlas(io.Fonts(), initialRanges.Data())
+	font := dynamic.AddFontDefaultV(imgui.DefaultFontConfig)
+	io.Fonts().TextureDataRGBA32()
+	dynamic.TrackSubmittedText()
+
+	text := "ö"
+	renderTestFrame(func() {
+		imgui.Begin("é###window")
+		imgui.Button("ä##ß")
+		imgui.InputText("##input", &text)
+		imgui.End()
+	})
+	dynamic.StopTracking()
+	renderTestFrame(func() { imgui.Text("ü") })
+	dynamic.Update(nil)
+
+	_, found := font.FindGlyphNoFallback('ö')
+	assert.True(t, found, "Text of input fields should be tracked")
+	_, found = font.FindGlyphNoFallback('ä')
+	assert.True(t, found, "Visible part of labels should be tracked")
+	_, found = font.FindGlyphNoFallback('é')
+	assert.True(t, found, "Visible part of window titles should be tracked")
+	_, found = font.FindGlyphNoFallback('ß')
+	assert.False(t, found, "Hidden part of labels should not be tracked")
+	_, found = font.FindGlyphNoFallback('ü')
+	assert.False(t, found, "Text should not be tracked after StopTracking()")
+}
@@ -0,0 +1,687 @@
+//go:build go1.16
+// +build go1.16
+
+package imgui
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// FileDialogMode determines what a FileDialog selects.
+type FileDialogMode int
+
+const (
+	// FileDialogModeOpen selects one or more existing files.
+	FileDialogModeOpen FileDialogMode = iota
+	// FileDialogModeSave selects a file to save to, which may not exist yet.
+	// The user confirms to overwrite an existing file.
+	FileDialogModeSave
+	// FileDialogModeSelectDirectory selects a directory. Only directories are listed.
+	FileDialogModeSelectDirectory
+)
+
+// FileDialogResult is returned by the render functions of a FileDialog.
+type FileDialogResult int
+
+const (
+	// FileDialogResultNone is returned while the user is still selecting.
+	FileDialogResultNone FileDialogResult = iota
+	// FileDialogResultConfirmed is returned once the user confirmed the selection, which is available from Paths().
+	FileDialogResultConfirmed
+	// FileDialogResultCancelled is returned once the user cancelled the dialog.
+	FileDialogResultCancelled
+)
+
+// FileDialogFilter restricts the listed files to some extensions.
+type FileDialogFilter struct {
+	// Label is displayed in the filter combo, such as "Images (*.png, *.jpg)".
+	Label string
+	// Extensions are the extensions of the listed files, including the dot, such as ".png".
+	// They are compared without regard to case. Without any extension, all files are listed.
+	Extensions []string
+}
+
+func (filter FileDialogFilter) matches(name string) bool {
+	if len(filter.Extensions) == 0 {
+		return true
+	}
+	extension := path.Ext(name)
+	for _, candidate := range filter.Extensions {
+		if strings.EqualFold(candidate, extension) {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	fileDialogColumnName = iota
+	fileDialogColumnSize
+	fileDialogColumnModified
+)
+
+const fileDialogOverwritePopupID = "Overwrite?"
+
+var fileDialogConfirmLabels = map[FileDialogMode]string{
+	FileDialogModeOpen:            "Open",
+	FileDialogModeSave:            "Save",
+	FileDialogModeSelectDirectory: "Select",
+}
+
+type fileDialogEntry struct {
+	name     string
+	dir      bool
+	size     int64
+	modified time.Time
+}
+
+// fileDialogListing is the data source of the table of a FileDialog.
+type fileDialogListing struct {
+	entries   []fileDialogEntry
+	activated int
+}
+
+func (listing *fileDialogListing) RowCount() int {
+	return len(listing.entries)
+}
+
+func (listing *fileDialogListing) CellText(row, column int) string {
+	entry := listing.entries[row]
+	switch column {
+	case fileDialogColumnName:
+		if entry.dir {
+			return entry.name + "/"
+		}
+		return entry.name
+	case fileDialogColumnSize:
+		if entry.dir {
+			return ""
+		}
+		return formatFileSize(entry.size)
+	default:
+		if entry.modified.IsZero() {
+			return ""
+		}
+		return entry.modified.Format("2006-01-02 15:04")
+	}
+}
+
+func (listing *fileDialogListing) RenderCell(row, column int) {
+	// The first column is rendered right after the selectable of the row, which is the last item.
+	if (column == fileDialogColumnName) && IsItemHovered() && IsMouseDoubleClicked(0) {
+		listing.activated = row
+	}
+	Text(listing.CellText(row, column))
+}
+
+// compare orders directories before files, then by the given comparison.
+func (listing *fileDialogListing) compare(a, b int, byValue func(a, b fileDialogEntry) int) int {
+	entryA, entryB := listing.entries[a], listing.entries[b]
+	if entryA.dir != entryB.dir {
+		if entryA.dir {
+			return -1
+		}
+		return 1
+	}
+	return byValue(entryA, entryB)
+}
+
+func compareFileNames(a, b fileDialogEntry) int {
+	if result := strings.Compare(strings.ToLower(a.name), strings.ToLower(b.name)); result != 0 {
+		return result
+	}
+	return strings.Compare(a.name, b.name)
+}
+
+func formatFileSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	value := float64(size) / unit
+	for _, suffix := range []string{"KB", "MB", "GB", "TB"} {
+		if value < unit {
+			return fmt.Sprintf("%.1f %s", value, suffix)
+		}
+		value /= unit
+	}
+	return fmt.Sprintf("%.1f PB", value)
+}
+
+// FileDialog is a dialog to browse a file system, selecting files to open or save, or a directory.
+//
+// It works on any fs.FS, or on the file system of the operating system. The dialog is either rendered into the
+// current window with Render(), or as modal popup with RenderModal() after a call to Open().
+// Paths are slash-separated paths of the fs.FS, or paths of the operating system for a dialog of NewOSFileDialog().
+//
+// The listing is a TableView, sortable by name, size and modification time, with multiple selection if
+// MultiSelect is set. Double-click or Enter opens a directory, or confirms a file. Backspace opens the parent directory.
+// Files that are dropped onto the listing from outside the application are selected, see also DropFiles().
+//
+// As it is based on the io/fs package, the dialog is only available when building with Go 1.16 or newer.
+//
+// Usage:
+//   dialog := imgui.NewFileDialog("Open image", os.DirFS("assets"), ".")
+//   dialog.Filters = []imgui.FileDialogFilter{{Label: "Images", Extensions: []string{".png", ".jpg"}}}
+//   ...
+//   if imgui.Button("Open...") {
+//       dialog.Open()
+//   }
+//   if dialog.RenderModal() == imgui.FileDialogResultConfirmed {
+//       load(dialog.Paths())
+//   }
+type FileDialog struct {
+	// Title is the title of the modal popup, and the identifier of the dialog.
+	Title string
+	// Mode determines what the dialog selects.
+	Mode FileDialogMode
+	// MultiSelect allows to select more than one file in FileDialogModeOpen.
+	MultiSelect bool
+	// Filters are offered in a combo to restrict the listed files. The first filter is active initially.
+	// Call Refresh() after changing the filters.
+	Filters []FileDialogFilter
+	// ShowHidden lists files whose names start with a dot. The user can toggle it.
+	// Call Refresh() after changing it.
+	ShowHidden bool
+
+	fsys   fs.FS
+	osRoot string
+	dir    string
+	err    error
+
+	listing          fileDialogListing
+	view             *TableView
+	filter           int
+	fileName         string
+	fileNameErr      error
+	overwritePath    string
+	pendingSelection map[string]bool
+	openRequested    bool
+	paths            []string
+}
+
+// NewFileDialog returns a dialog browsing the given file system, starting in the given directory.
+// The directory is a slash-separated path as accepted by fs.ValidPath(), with "." being the root.
+func NewFileDialog(title string, fsys fs.FS, dir string) *FileDialog {
+	dialog := &FileDialog{Title: title, fsys: fsys}
+	dialog.initView()
+	dialog.navigate(path.Clean(dir))
+	return dialog
+}
+
+// NewOSFileDialog returns a dialog browsing the file system of the operating system, starting in the given directory.
+// A relative directory is relative to the working directory. Only the volume of the directory can be browsed.
+func NewOSFileDialog(title string, dir string) (*FileDialog, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	root := filepath.VolumeName(abs) + string(filepath.Separator)
+	dialog := &FileDialog{Title: title, fsys: os.DirFS(root), osRoot: root}
+	dialog.initView()
+	fsDir, _ := dialog.fsPath(abs)
+	dialog.navigate(fsDir)
+	return dialog, nil
+}
+
+func (dialog *FileDialog) initView() {
+	listing := &dialog.listing
+	dialog.view = NewTableView("##listing", []TableViewColumn{
+		{
+			Label: "Name",
+			Flags: TableColumnFlagsWidthStretch | TableColumnFlagsNoHide | TableColumnFlagsDefaultSort,
+			Compare: func(a, b int) int {
+				return listing.compare(a, b, compareFileNames)
+			},
+		},
+		{
+			Label: "Size",
+			Flags: TableColumnFlagsWidthFixed,
+			Compare: func(a, b int) int {
+				return listing.compare(a, b, func(entryA, entryB fileDialogEntry) int {
+					switch {
+					case entryA.size < entryB.size:
+						return -1
+					case entryA.size > entryB.size:
+						return 1
+					default:
+						return compareFileNames(entryA, entryB)
+					}
+				})
+			},
+		},
+		{
+			Label: "Modified",
+			Flags: TableColumnFlagsWidthFixed,
+			Compare: func(a, b int) int {
+				return listing.compare(a, b, func(entryA, entryB fileDialogEntry) int {
+					switch {
+					case entryA.modified.Before(entryB.modified):
+						return -1
+					case entryA.modified.After(entryB.modified):
+						return 1
+					default:
+						return compareFileNames(entryA, entryB)
+					}
+				})
+			},
+		},
+	})
+}
+
+// fsPath converts a path as it is used by the caller into a path of the file system.
+func (dialog *FileDialog) fsPath(name string) (string, bool) {
+	if dialog.osRoot == "" {
+		name = path.Clean(name)
+		return name, fs.ValidPath(name)
+	}
+	abs, err := filepath.Abs(name)
+	if err != nil {
+		return "", false
+	}
+	rel, err := filepath.Rel(dialog.osRoot, abs)
+	if (err != nil) || (rel == "..") || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.ToSlash(rel), true
+}
+
+// externalPath converts a path of the file system into a path as it is used by the caller.
+func (dialog *FileDialog) externalPath(fsPath string) string {
+	if dialog.osRoot == "" {
+		return fsPath
+	}
+	return filepath.Join(dialog.osRoot, filepath.FromSlash(fsPath))
+}
+
+// Dir returns the directory that is currently listed.
+func (dialog *FileDialog) Dir() string {
+	return dialog.externalPath(dialog.dir)
+}
+
+// SetDir lists the given directory.
+func (dialog *FileDialog) SetDir(dir string) error {
+	fsDir, valid := dialog.fsPath(dir)
+	if !valid {
+		return &fs.PathError{Op: "open", Path: dir, Err: fs.ErrInvalid}
+	}
+	dialog.navigate(fsDir)
+	return dialog.err
+}
+
+// Paths returns the paths the user confirmed last.
+// In FileDialogModeSave, this is the path of the file to save, with the extension of the active filter
+// appended if the entered name has none.
+func (dialog *FileDialog) Paths() []string {
+	return dialog.paths
+}
+
+// Open requests to open the modal popup during the next call to RenderModal().
+// The listing is refreshed, as the file system may have changed while the dialog was closed.
+func (dialog *FileDialog) Open() {
+	dialog.openRequested = true
+}
+
+func (dialog *FileDialog) navigate(dir string) {
+	dialog.dir = dir
+	dialog.view.ClearSelection()
+	dialog.Refresh()
+}
+
+// Refresh reads the listed directory again.
+func (dialog *FileDialog) Refresh() {
+	var filter FileDialogFilter
+	if dialog.filter < len(dialog.Filters) {
+		filter = dialog.Filters[dialog.filter]
+	}
+	entries, err := fs.ReadDir(dialog.fsys, dialog.dir)
+	dialog.err = err
+	dialog.listing.entries = dialog.listing.entries[:0]
+	for _, entry := range entries {
+		name := entry.Name()
+		if !dialog.ShowHidden && strings.HasPrefix(name, ".") {
+			continue
+		}
+		info, infoErr := entry.Info()
+		if (entry.Type() & fs.ModeSymlink) != 0 {
+			info, infoErr = fs.Stat(dialog.fsys, path.Join(dialog.dir, name))
+		}
+		isDir := entry.IsDir() || ((infoErr == nil) && info.IsDir())
+		if !isDir && ((dialog.Mode == FileDialogModeSelectDirectory) || !filter.matches(name)) {
+			continue
+		}
+		listed := fileDialogEntry{name: name, dir: isDir}
+		if infoErr == nil {
+			listed.size = info.Size()
+			listed.modified = info.ModTime()
+		}
+		dialog.listing.entries = append(dialog.listing.entries, listed)
+	}
+	sort.SliceStable(dialog.listing.entries, func(a, b int) bool {
+		return dialog.listing.compare(a, b, compareFileNames) < 0
+	})
+	dialog.view.InvalidateSort()
+}
+
+// DropFiles shows files that were dropped onto the application from outside, such as reported by
+// GLFW.SetDropCallback(). A dropped directory is listed. Otherwise, the directory of the first dropped file is listed,
+// and the dropped files within it are selected. Files outside of the file system of the dialog are ignored.
+//
+// The listing of the dialog is a drag'n'drop target that calls DropFiles() for files delivered by DropExternFiles(),
+// so it needs only be called explicitly to accept files dropped anywhere onto the application.
+func (dialog *FileDialog) DropFiles(names []string) {
+	dir := ""
+	selected := make(map[string]bool)
+	for _, name := range names {
+		fsName, valid := dialog.fsPath(name)
+		if !valid {
+			continue
+		}
+		info, err := fs.Stat(dialog.fsys, fsName)
+		if err != nil {
+			continue
+		}
+		if info.IsDir() {
+			if dir == "" {
+				dialog.navigate(fsName)
+				return
+			}
+			continue
+		}
+		if dir == "" {
+			dir = path.Dir(fsName)
+		}
+		if path.Dir(fsName) == dir {
+			selected[path.Base(fsName)] = true
+		}
+	}
+	if dir == "" {
+		return
+	}
+	dialog.navigate(dir)
+	dialog.pendingSelection = selected
+}
+
+// Render renders the dialog into the current window, filling the available space.
+func (dialog *FileDialog) Render() FileDialogResult {
+	PushID(dialog.Title)
+	defer PopID()
+	return dialog.renderContent()
+}
+
+// RenderModal renders the dialog as modal popup, if it was opened with Open().
+// The popup is closed once the user confirms or cancels.
+func (dialog *FileDialog) RenderModal() FileDialogResult {
+	if dialog.openRequested {
+		dialog.openRequested = false
+		dialog.Refresh()
+		OpenPopup(dialog.Title)
+	}
+	SetNextWindowSizeV(Vec2{X: 720, Y: 440}, ConditionFirstUseEver)
+	if !BeginPopupModalV(dialog.Title, nil, 0) {
+		return FileDialogResultNone
+	}
+	result := dialog.renderContent()
+	if result != FileDialogResultNone {
+		CloseCurrentPopup()
+	}
+	EndPopup()
+	return result
+}
+
+func (dialog *FileDialog) renderContent() FileDialogResult {
+	result := FileDialogResultNone
+	dialog.renderBreadcrumbs()
+	if dialog.err != nil {
+		PushStyleColor(StyleColorText, Vec4{X: 1, Y: 0.4, Z: 0.4, W: 1})
+		Text(dialog.err.Error())
+		PopStyleColor()
+	}
+
+	BeginChildV("##files", Vec2{Y: -FrameHeightWithSpacing() * 2}, false, 0)
+	dialog.listing.activated = -1
+	selectionChanged := dialog.view.Render(&dialog.listing)
+	if dialog.pendingSelection != nil {
+		dialog.view.ClearSelection()
+		for row, entry := range dialog.listing.entries {
+			if dialog.pendingSelection[entry.name] {
+				dialog.view.SetSelected(row, true)
+			}
+		}
+		dialog.pendingSelection = nil
+		selectionChanged = true
+	}
+	if selectionChanged {
+		dialog.selectionChanged()
+	}
+	if IsWindowFocusedV(FocusedFlagsChildWindows) && !IsAnyItemActive() {
+		if IsKeyPressedV(KeyIndex(KeyEnter), false) && (dialog.view.FocusedRow() >= 0) {
+			dialog.listing.activated = dialog.view.FocusedRow()
+		} else if IsKeyPressed(KeyIndex(KeyBackspace)) {
+			dialog.navigate(path.Dir(dialog.dir))
+		}
+	}
+	EndChild()
+	if BeginDragDropTarget() {
+		if names := AcceptDragDropFiles(DragDropFlagsNone); names != nil {
+			dialog.DropFiles(names)
+		}
+		EndDragDropTarget()
+	}
+	if dialog.listing.activated >= 0 {
+		result = dialog.activate(dialog.listing.entries[dialog.listing.activated])
+	}
+
+	if dialog.Mode == FileDialogModeSave {
+		if dialog.fileNameErr != nil {
+			PushStyleColor(StyleColorFrameBg, Vec4{X: 0.6, Y: 0.15, Z: 0.15, W: 1})
+		}
+		SetNextItemWidth(CalcItemWidth())
+		entered := InputTextV("File name", &dialog.fileName, InputTextFlagsEnterReturnsTrue, nil)
+		if dialog.fileNameErr != nil {
+			PopStyleColor()
+			if IsItemHovered() {
+				SetTooltip(dialog.fileNameErr.Error())
+			}
+			if IsItemEdited() {
+				dialog.fileNameErr = nil
+			}
+		}
+		if entered {
+			result = dialog.confirm()
+		}
+	}
+	if len(dialog.Filters) > 0 {
+		if dialog.filter >= len(dialog.Filters) {
+			dialog.filter = 0
+		}
+		if dialog.Mode == FileDialogModeSave {
+			SameLine()
+		}
+		SetNextItemWidth(CalcTextSize("M", false, 0).X * 16)
+		if BeginCombo("##filter", dialog.Filters[dialog.filter].Label) {
+			for index, filter := range dialog.Filters {
+				PushIDInt(index)
+				if SelectableV(filter.Label, index == dialog.filter, 0, Vec2{}) {
+					dialog.filter = index
+					dialog.Refresh()
+				}
+				PopID()
+			}
+			EndCombo()
+		}
+		SameLine()
+	}
+	if Checkbox("Show hidden", &dialog.ShowHidden) {
+		dialog.Refresh()
+	}
+
+	if Button(fileDialogConfirmLabels[dialog.Mode]) {
+		result = dialog.confirm()
+	}
+	SameLine()
+	if Button("Cancel") {
+		result = FileDialogResultCancelled
+	}
+	if overwriteResult := dialog.renderOverwriteConfirmation(); overwriteResult != FileDialogResultNone {
+		result = overwriteResult
+	}
+	return result
+}
+
+func (dialog *FileDialog) renderBreadcrumbs() {
+	if ArrowButton("##up", DirUp) && (dialog.dir != ".") {
+		dialog.navigate(path.Dir(dialog.dir))
+	}
+	rootLabel := "/"
+	if dialog.osRoot != "" {
+		rootLabel = dialog.osRoot
+	}
+	SameLine()
+	if Button(rootLabel + "##root") {
+		dialog.navigate(".")
+	}
+	if dialog.dir == "." {
+		return
+	}
+	spacing := CurrentStyle().ItemInnerSpacing().X
+	parts := strings.Split(dialog.dir, "/")
+	for index, part := range parts {
+		SameLineV(0, spacing)
+		PushIDInt(index)
+		if Button(part) {
+			dialog.navigate(strings.Join(parts[:index+1], "/"))
+		}
+		PopID()
+	}
+}
+
+func (dialog *FileDialog) selectedEntries() []fileDialogEntry {
+	rows := dialog.view.Selection()
+	entries := make([]fileDialogEntry, 0, len(rows))
+	for _, row := range rows {
+		if row < len(dialog.listing.entries) {
+			entries = append(entries, dialog.listing.entries[row])
+		}
+	}
+	return entries
+}
+
+func (dialog *FileDialog) selectionChanged() {
+	if !dialog.MultiSelect && (len(dialog.view.Selection()) > 1) {
+		focused := dialog.view.FocusedRow()
+		dialog.view.ClearSelection()
+		dialog.view.SetSelected(focused, true)
+	}
+	if selected := dialog.selectedEntries(); (dialog.Mode == FileDialogModeSave) && (len(selected) == 1) &&
+		!selected[0].dir {
+		dialog.fileName = selected[0].name
+	}
+}
+
+// activate handles a double-click or Enter on an entry.
+func (dialog *FileDialog) activate(entry fileDialogEntry) FileDialogResult {
+	switch {
+	case entry.dir:
+		dialog.navigate(path.Join(dialog.dir, entry.name))
+		return FileDialogResultNone
+	case dialog.Mode == FileDialogModeOpen:
+		dialog.paths = []string{dialog.externalPath(path.Join(dialog.dir, entry.name))}
+		return FileDialogResultConfirmed
+	case dialog.Mode == FileDialogModeSave:
+		dialog.fileName = entry.name
+		return dialog.confirm()
+	default:
+		return FileDialogResultNone
+	}
+}
+
+func (dialog *FileDialog) confirm() FileDialogResult {
+	selected := dialog.selectedEntries()
+	switch dialog.Mode {
+	case FileDialogModeOpen:
+		if (len(selected) == 1) && selected[0].dir {
+			dialog.navigate(path.Join(dialog.dir, selected[0].name))
+			return FileDialogResultNone
+		}
+		var paths []string
+		for _, entry := range selected {
+			if !entry.dir {
+				paths = append(paths, dialog.externalPath(path.Join(dialog.dir, entry.name)))
+			}
+		}
+		if len(paths) == 0 {
+			return FileDialogResultNone
+		}
+		dialog.paths = paths
+		return FileDialogResultConfirmed
+	case FileDialogModeSave:
+		return dialog.confirmSave()
+	default:
+		dir := dialog.dir
+		if (len(selected) == 1) && selected[0].dir {
+			dir = path.Join(dialog.dir, selected[0].name)
+		}
+		dialog.paths = []string{dialog.externalPath(dir)}
+		return FileDialogResultConfirmed
+	}
+}
+
+func (dialog *FileDialog) confirmSave() FileDialogResult {
+	name := strings.TrimSpace(dialog.fileName)
+	dialog.fileNameErr = nil
+	if name == "" {
+		return FileDialogResultNone
+	}
+	if (name == ".") || (name == "..") || strings.ContainsAny(name, `/\`) {
+		dialog.fileNameErr = fmt.Errorf("%q is not a valid file name", name)
+		return FileDialogResultNone
+	}
+	if (path.Ext(name) == "") && (dialog.filter < len(dialog.Filters)) &&
+		(len(dialog.Filters[dialog.filter].Extensions) > 0) {
+		name += dialog.Filters[dialog.filter].Extensions[0]
+	}
+	target := path.Join(dialog.dir, name)
+	if !fs.ValidPath(target) {
+		dialog.fileNameErr = fmt.Errorf("%q is not a valid file name", name)
+		return FileDialogResultNone
+	}
+	if info, err := fs.Stat(dialog.fsys, target); err == nil {
+		if info.IsDir() {
+			dialog.fileName = ""
+			dialog.navigate(target)
+			return FileDialogResultNone
+		}
+		dialog.overwritePath = target
+		OpenPopup(fileDialogOverwritePopupID)
+		return FileDialogResultNone
+	}
+	dialog.paths = []string{dialog.externalPath(target)}
+	return FileDialogResultConfirmed
+}
+
+func (dialog *FileDialog) renderOverwriteConfirmation() FileDialogResult {
+	if !BeginPopupModalV(fileDialogOverwritePopupID, nil, WindowFlagsAlwaysAutoResize) {
+		return FileDialogResultNone
+	}
+	result := FileDialogResultNone
+	Text(path.Base(dialog.overwritePath) + " already exists. Do you want to replace it?")
+	if Button("Replace") {
+		dialog.paths = []string{dialog.externalPath(dialog.overwritePath)}
+		result = FileDialogResultConfirmed
+		CloseCurrentPopup()
+	}
+	SameLine()
+	if Button("Cancel") {
+		CloseCurrentPopup()
+	}
+	EndPopup()
+	return result
+}
@@ -0,0 +1,137 @@
+//go:build go1.16
+// +build go1.16
+
+package imgui_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/ianling/imgui-go"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fileDialogTestFS() fstest.MapFS {
+	return fstest.MapFS{
+		"docs/readme.md":  {Data: []byte("# Readme")},
+		"docs/image.png":  {Data: []byte{0x89}},
+		"docs/guide.MD":   {Data: []byte("# Guide")},
+		".hidden/file.md": {Data: []byte{}},
+		"notes.txt":       {Data: []byte("notes")},
+	}
+}
+
+// renderFileDialog renders the dialog for one frame, with given state of the left mouse button.
+func renderFileDialog(dialog *imgui.FileDialog, mouseDown bool) imgui.FileDialogResult {
+	imgui.CurrentIO().SetMouseButtonDown(0, mouseDown)
+	var result imgui.FileDialogResult
+	renderTestWindow(func() { result = dialog.Render() })
+	return result
+}
+
+// doubleClickFileDialog double-clicks the given position, returning the last result other than none.
+func doubleClickFileDialog(dialog *imgui.FileDialog, pos imgui.Vec2) imgui.FileDialogResult {
+	imgui.CurrentIO().SetMousePosition(pos)
+	result := imgui.FileDialogResultNone
+	for _, down := range []bool{false, true, false, true, false, false} {
+		if frameResult := renderFileDialog(dialog, down); frameResult != imgui.FileDialogResultNone {
+			result = frameResult
+		}
+	}
+	return result
+}
+
+func TestFileDialogNavigatesAndOpens(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 600, Y: 400})
+	defer context.Destroy()
+	io := imgui.CurrentIO()
+
+	dialog := imgui.NewFileDialog("Open", fileDialogTestFS(), ".")
+	dialog.Filters = []imgui.FileDialogFilter{{Label: "Markdown", Extensions: []string{".md"}}}
+	dialog.Refresh()
+	firstRow := imgui.Vec2{X: 100, Y: 55}
+	// Clicks are only recognized as part of a double-click once the context is older than the double-click time.
+	io.SetDeltaTime(1)
+	renderFileDialog(dialog, false)
+	io.SetDeltaTime(1.0 / 60)
+
+	assert.Equal(t, imgui.FileDialogResultNone, doubleClickFileDialog(dialog, firstRow))
+	assert.Equal(t, "docs", dialog.Dir(), "Double-click on directory should open it")
+
+	assert.Equal(t, imgui.FileDialogResultConfirmed, doubleClickFileDialog(dialog, firstRow))
+	assert.Equal(t, []string{"docs/guide.MD"}, dialog.Paths(), "Files should be filtered and sorted by name")
+}
+
+func TestFileDialogDropFiles(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 600, Y: 400})
+	defer context.Destroy()
+	io := imgui.CurrentIO()
+
+	dialog := imgui.NewFileDialog("Open", fileDialogTestFS(), ".")
+	dialog.MultiSelect = true
+	dialog.DropFiles([]string{"missing.txt", "docs/readme.md", "notes.txt", "docs/image.png"})
+	assert.Equal(t, "docs", dialog.Dir())
+
+	io.SetMousePosition(imgui.Vec2{X: 20, Y: 382})
+	result := imgui.FileDialogResultNone
+	for _, down := range []bool{false, true, false} {
+		if frameResult := renderFileDialog(dialog, down); frameResult != imgui.FileDialogResultNone {
+			result = frameResult
+		}
+	}
+	assert.Equal(t, imgui.FileDialogResultConfirmed, result, "Open button should confirm the dropped files")
+	assert.Equal(t, []string{"docs/image.png", "docs/readme.md"}, dialog.Paths())
+
+	dialog.DropFiles([]string{".hidden"})
+	assert.Equal(t, ".hidden", dialog.Dir(), "Dropped directory should be listed, even if hidden")
+
+	require.Nil(t, dialog.SetDir("."))
+	assert.NotNil(t, dialog.SetDir("../outside"))
+}
+
+func TestFileDialogRejectsInvalidSaveNames(t *testing.T) {
+	tt := []struct {
+		name      string
+		confirmed bool
+	}{
+		{name: "../x", confirmed: false},
+		{name: "a/../../b", confirmed: false},
+		{name: `docs\x`, confirmed: false},
+		{name: "..", confirmed: false},
+		{name: "new.txt", confirmed: true},
+	}
+	for _, tc := range tt {
+		td := tc
+		t.Run(td.name, func(t *testing.T) {
+			context := newTestContext(imgui.Vec2{X: 600, Y: 400})
+			defer context.Destroy()
+			io := imgui.CurrentIO()
+			const keyEnter = 1
+			io.KeyMap(imgui.KeyEnter, keyEnter)
+
+			dialog := imgui.NewFileDialog("Save", fileDialogTestFS(), "docs")
+			dialog.Mode = imgui.FileDialogModeSave
+			// The file name input is above the row of buttons at the bottom.
+			io.SetMousePosition(imgui.Vec2{X: 50, Y: 359})
+			renderFileDialog(dialog, false)
+			renderFileDialog(dialog, true)
+			renderFileDialog(dialog, false)
+			io.AddInputCharacters(td.name)
+			renderFileDialog(dialog, false)
+			io.KeyPress(keyEnter)
+			result := renderFileDialog(dialog, false)
+			io.KeyRelease(keyEnter)
+			renderFileDialog(dialog, false)
+
+			if td.confirmed {
+				assert.Equal(t, imgui.FileDialogResultConfirmed, result)
+				assert.Equal(t, []string{"docs/new.txt"}, dialog.Paths())
+			} else {
+				assert.Equal(t, imgui.FileDialogResultNone, result, "Invalid name should not be confirmed")
+				assert.Empty(t, dialog.Paths())
+			}
+		})
+	}
+}
@@ -4,11 +4,13 @@ package imgui
 // #include "wrapper/Types.h"
 import "C"
 
+import "strings"
+
 // Font describes one loaded font in an atlas.
 type Font uintptr
 
 // DefaultFont can be used to refer to the default font of the current font atlas without
-// having the actual font reference.
+// having the actual font reference. The metric functions of DefaultFont query the default font of the current context.
 const DefaultFont Font = 0
 
 // PushFont adds the given font on the stack. Use DefaultFont to refer to the default font.
@@ -28,7 +30,12 @@ func FontSize() float32 {
 
 // CalcTextSize calculates the size of the text.
 func CalcTextSize(text string, hideTextAfterDoubleHash bool, wrapWidth float32) Vec2 {
-	CString := newStringBuffer(text)
+	if hideTextAfterDoubleHash {
+		if index := strings.Index(text, "##"); index >= 0 {
+			text = text[:index]
+		}
+	}
+	CString := newStringBuffer(ShapeText(text))
 	defer CString.free()
 
 	var vec2 Vec2
@@ -43,3 +50,130 @@ func CalcTextSize(text string, hideTextAfterDoubleHash bool, wrapWidth float32)
 func (font Font) handle() C.IggFont {
 	return C.IggFont(font)
 }
+
+// CurrentFont returns the font currently used for rendering, as selected with PushFont().
+func CurrentFont() Font {
+	return Font(C.iggGetFont())
+}
+
+// FontGlyph describes a single glyph of a font.
+type FontGlyph struct {
+	// Codepoint is the character the glyph was loaded for. It is the fallback character if a fallback was returned.
+	Codepoint rune
+	// Colored is set for glyphs that should be rendered without tinting, such as color emoji.
+	Colored bool
+	// Visible is false for glyphs without any pixels, such as space.
+	Visible bool
+	// AdvanceX is the distance to the next character, in pixels at the size of the font.
+	AdvanceX float32
+	// Min and Max are the corners of the glyph, relative to the pen position at the top of the line.
+	Min, Max Vec2
+	// UV0 and UV1 are the texture coordinates of the corners within the font atlas.
+	UV0, UV1 Vec2
+}
+
+// FontSize returns the height of characters and lines, in pixels, as the font was loaded.
+// Unlike the global FontSize(), this does not include any scaling.
+func (font Font) FontSize() float32 {
+	return float32(C.iggFontFontSize(font.handle()))
+}
+
+// Ascent returns the distance from the top of the line to the baseline, in pixels at the size of the font.
+func (font Font) Ascent() float32 {
+	return float32(C.iggFontAscent(font.handle()))
+}
+
+// Descent returns the distance from the baseline to the bottom of the line, in pixels at the size of the font.
+// The value is typically negative.
+func (font Font) Descent() float32 {
+	return float32(C.iggFontDescent(font.handle()))
+}
+
+// FallbackChar returns the character that is rendered in place of characters without a glyph.
+func (font Font) FallbackChar() rune {
+	return rune(C.iggFontFallbackChar(font.handle()))
+}
+
+// EllipsisChar returns the character used to render an ellipsis, or -1 if the font has none.
+// Without an ellipsis character, imgui renders three dots.
+func (font Font) EllipsisChar() rune {
+	return rune(C.iggFontEllipsisChar(font.handle()))
+}
+
+// CharAdvance returns the distance to the next character for given character, in pixels at the size of the font.
+func (font Font) CharAdvance(c rune) float32 {
+	return float32(C.iggFontCharAdvance(font.handle(), C.uint(c)))
+}
+
+// FindGlyph returns the glyph for given character, or the glyph of the fallback character if the font has none.
+func (font Font) FindGlyph(c rune) FontGlyph {
+	glyph, _ := font.findGlyph(c, true)
+	return glyph
+}
+
+// FindGlyphNoFallback returns the glyph for given character, and false if the font has none.
+func (font Font) FindGlyphNoFallback(c rune) (FontGlyph, bool) {
+	return font.findGlyph(c, false)
+}
+
+func (font Font) findGlyph(c rune, fallback bool) (FontGlyph, bool) {
+	var glyph C.IggFontGlyph
+	if C.iggFontFindGlyph(font.handle(), C.uint(c), castBool(fallback), &glyph) == 0 {
+		return FontGlyph{}, false
+	}
+	return importFontGlyph(&glyph), true
+}
+
+// GlyphCount returns the number of glyphs the font contains. It is zero until the atlas was built.
+func (font Font) GlyphCount() int {
+	return int(C.iggFontGlyphCount(font.handle()))
+}
+
+// GlyphByIndex returns the glyph at given index, which must be less than GlyphCount().
+func (font Font) GlyphByIndex(index int) FontGlyph {
+	var glyph C.IggFontGlyph
+	C.iggFontGlyphByIndex(font.handle(), C.int(index), &glyph)
+	return importFontGlyph(&glyph)
+}
+
+func importFontGlyph(glyph *C.IggFontGlyph) FontGlyph {
+	return FontGlyph{
+		Codepoint: rune(glyph.codepoint),
+		Colored:   glyph.colored != 0,
+		Visible:   glyph.visible != 0,
+		AdvanceX:  float32(glyph.advanceX),
+		Min:       Vec2{X: float32(glyph.min.x), Y: float32(glyph.min.y)},
+		Max:       Vec2{X: float32(glyph.max.x), Y: float32(glyph.max.y)},
+		UV0:       Vec2{X: float32(glyph.uv0.x), Y: float32(glyph.uv0.y)},
+		UV1:       Vec2{X: float32(glyph.uv1.x), Y: float32(glyph.uv1.y)},
+	}
+}
+
+// CalcTextSizeA calculates the size of the text when rendered with the font at given size in pixels.
+// Lines are wrapped at word boundaries if wrapWidth is greater than zero. Measuring stops at the character
+// that would exceed maxWidth; use math.MaxFloat32 for no limit.
+// The returned length is the number of bytes of text that were measured, which is less than len(text)
+// if measuring stopped at maxWidth.
+func (font Font) CalcTextSizeA(size, maxWidth, wrapWidth float32, text string) (textSize Vec2, length int) {
+	CString := newStringBuffer(text)
+	defer CString.free()
+
+	valueArg, returnFunc := textSize.wrapped()
+	var remaining C.int
+	C.iggFontCalcTextSizeA(font.handle(), C.float(size), C.float(maxWidth), C.float(wrapWidth),
+		(*C.char)(CString.ptr), C.int(CString.size)-1, valueArg, &remaining)
+	returnFunc()
+
+	return textSize, int(remaining)
+}
+
+// CalcWordWrapPositionA returns the byte offset into text at which the first line ends when wrapped at wrapWidth.
+// Blanks at the offset belong to the wrap and are not rendered at the start of the next line.
+// scale is the size to render at, relative to FontSize(). The returned offset is len(text) if no wrap is necessary.
+func (font Font) CalcWordWrapPositionA(scale float32, text string, wrapWidth float32) int {
+	CString := newStringBuffer(text)
+	defer CString.free()
+
+	return int(C.iggFontCalcWordWrapPositionA(font.handle(), C.float(scale),
+		(*C.char)(CString.ptr), C.int(CString.size)-1, C.float(wrapWidth)))
+}
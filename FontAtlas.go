@@ -24,6 +24,7 @@ func (atlas FontAtlas) handle() C.IggFontAtlas {
 	return C.IggFontAtlas(atlas)
 }
 
+// GlyphRangesAll returns the ranges of all characters up to UnicodeCodepointMax.
 func GlyphRangesAll() GlyphRanges {
 	return GlyphRanges(C.iggGetGlyphRangesAll())
 }
@@ -141,6 +142,7 @@ func (atlas FontAtlas) SetTexDesiredWidth(value int) {
 }
 
 // TextureDataAlpha8 returns the image in 8-bit alpha values for the font atlas.
+// Atlases that use colors, see TexPixelsUseColors(), are only available with TextureDataRGBA32().
 // The returned image is valid as long as the font atlas is.
 func (atlas FontAtlas) TextureDataAlpha8() *Alpha8Image {
 	var pixels *C.uchar
@@ -187,6 +189,83 @@ func (atlas FontAtlas) Build() bool {
 	return C.iggFontAtlasBuild(atlas.handle()) != 0
 }
 
+// IsBuilt returns true if the texture data of the atlas was built and not cleared since.
+func (atlas FontAtlas) IsBuilt() bool {
+	return C.iggFontAtlasIsBuilt(atlas.handle()) != 0
+}
+
+// ClearTexData clears the texture data on the CPU side. The fonts and their glyphs are kept.
+// This saves memory once the texture has been uploaded to graphics memory.
+func (atlas FontAtlas) ClearTexData() {
+	C.iggFontAtlasClearTexData(atlas.handle())
+}
+
+// TexPixelsUseColors returns true if the texture data of the built atlas uses colors, rather than just the alpha
+// channel. This is the case for color glyphs, for example those of emoji fonts loaded with FreeType.
+// Such an atlas is only available with TextureDataRGBA32().
+func (atlas FontAtlas) TexPixelsUseColors() bool {
+	return C.iggFontAtlasTexPixelsUseColors(atlas.handle()) != 0
+}
+
+// FontAtlasFlags for FontAtlas.SetFlags().
+type FontAtlasFlags int
+
+const (
+	// FontAtlasFlagsNone is the default.
+	FontAtlasFlagsNone FontAtlasFlags = 0
+	// FontAtlasFlagsNoPowerOfTwoHeight does not round the height to next power of two.
+	FontAtlasFlagsNoPowerOfTwoHeight FontAtlasFlags = 1 << 0
+	// FontAtlasFlagsNoMouseCursors does not build software mouse cursors into the atlas.
+	FontAtlasFlagsNoMouseCursors FontAtlasFlags = 1 << 1
+	// FontAtlasFlagsNoBakedLines does not build thick line textures into the atlas.
+	// Anti-aliased lines are then drawn with polygons.
+	FontAtlasFlagsNoBakedLines FontAtlasFlags = 1 << 2
+)
+
+// Flags returns the build flags of the atlas.
+func (atlas FontAtlas) Flags() FontAtlasFlags {
+	return FontAtlasFlags(C.iggFontAtlasGetFlags(atlas.handle()))
+}
+
+// SetFlags sets the build flags of the atlas. They take effect with the next build.
+func (atlas FontAtlas) SetFlags(flags FontAtlasFlags) {
+	C.iggFontAtlasSetFlags(atlas.handle(), C.int(flags))
+}
+
+// TexGlyphPadding returns the padding between glyphs within the texture, in pixels.
+func (atlas FontAtlas) TexGlyphPadding() int {
+	return int(C.iggFontAtlasGetTexGlyphPadding(atlas.handle()))
+}
+
+// SetTexGlyphPadding sets the padding between glyphs within the texture, in pixels. Default is 1.
+func (atlas FontAtlas) SetTexGlyphPadding(padding int) {
+	C.iggFontAtlasSetTexGlyphPadding(atlas.handle(), C.int(padding))
+}
+
+// FontCount returns the number of fonts in the atlas. Merged fonts count as one.
+func (atlas FontAtlas) FontCount() int {
+	return int(C.iggFontAtlasFontCount(atlas.handle()))
+}
+
+// FontByIndex returns the font at given index, which must be less than FontCount().
+func (atlas FontAtlas) FontByIndex(index int) Font {
+	return Font(C.iggFontAtlasFontByIndex(atlas.handle(), C.int(index)))
+}
+
+// fontOrDefault resolves DefaultFont to the actual font of this atlas, as described for AddCustomRectFontGlyph().
+// It returns DefaultFont if the atlas has no font.
+func (atlas FontAtlas) fontOrDefault(font Font) Font {
+	return Font(C.iggFontAtlasFontOrDefault(atlas.handle(), font.handle()))
+}
+
+func (atlas FontAtlas) configCount() int {
+	return int(C.iggFontAtlasConfigCount(atlas.handle()))
+}
+
+func (atlas FontAtlas) setConfigGlyphRanges(index int, ranges GlyphRanges) {
+	C.iggFontAtlasSetConfigGlyphRanges(atlas.handle(), C.int(index), ranges.handle())
+}
+
 // FontBuilderFlags returns shared flags (for all fonts) for custom font builder.
 func (atlas FontAtlas) FontBuilderFlags() uint {
 	return uint(C.iggFontAtlasGetFontBuilderFlags(atlas.handle()))
@@ -197,3 +276,87 @@ func (atlas FontAtlas) FontBuilderFlags() uint {
 func (atlas FontAtlas) SetFontBuilderFlags(flags uint) {
 	C.iggFontAtlasSetFontBuilderFlags(atlas.handle(), C.uint(flags))
 }
+
+// FontAtlasCustomRect describes a rectangle that is packed into the font atlas for custom pixel data.
+type FontAtlasCustomRect struct {
+	// Width and Height are the requested size of the rectangle, in pixels.
+	Width, Height int
+	// X and Y are the position of the rectangle in the texture, once the atlas was built. They are -1 before.
+	X, Y int
+	// GlyphID is the character of a custom font glyph, or zero for a regular rectangle.
+	GlyphID rune
+	// GlyphAdvanceX is the distance to the next character of a custom font glyph.
+	GlyphAdvanceX float32
+	// GlyphOffset is the offset of the glyph relative to the pen position at the top of the line.
+	GlyphOffset Vec2
+	// Font is the font the custom glyph is added to. It is zero for a regular rectangle.
+	Font Font
+}
+
+// IsPacked returns true if the rectangle has a position in the texture.
+func (rect FontAtlasCustomRect) IsPacked() bool {
+	return rect.X >= 0
+}
+
+// AddCustomRectRegular requests a rectangle of given size in pixels to be packed into the atlas.
+// It returns the index of the rectangle, to be used with CustomRectByIndex() after Build() to write pixel data
+// into the texture. Such rectangles can be drawn with Image() and CustomRectUV().
+func (atlas FontAtlas) AddCustomRectRegular(width, height int) int {
+	return int(C.iggFontAtlasAddCustomRectRegular(atlas.handle(), C.int(width), C.int(height)))
+}
+
+// AddCustomRectFontGlyph requests a rectangle of given size in pixels to be packed into the atlas,
+// which becomes the glyph of given character in given font. The font must already be added to the atlas.
+// DefaultFont refers to the default font of the current context if it belongs to this atlas, or to the first font
+// of the atlas otherwise.
+// advanceX is the distance to the next character, and offset the position of the glyph relative to the pen
+// position at the top of the line.
+// It returns the index of the rectangle, to be used with CustomRectByIndex() after Build() to write pixel data
+// into the texture, or -1 if the atlas has no font yet.
+func (atlas FontAtlas) AddCustomRectFontGlyph(font Font, id rune, width, height int, advanceX float32, offset Vec2) int {
+	font = atlas.fontOrDefault(font)
+	if font == DefaultFont {
+		return -1
+	}
+	offsetArg, _ := offset.wrapped()
+	return int(C.iggFontAtlasAddCustomRectFontGlyph(atlas.handle(), font.handle(), C.uint(id),
+		C.int(width), C.int(height), C.float(advanceX), offsetArg))
+}
+
+// CustomRectCount returns the number of custom rectangles in the atlas.
+func (atlas FontAtlas) CustomRectCount() int {
+	return int(C.iggFontAtlasCustomRectCount(atlas.handle()))
+}
+
+// CustomRectByIndex returns the custom rectangle with given index, as returned by the AddCustomRect*() functions.
+// The zero value, which is not packed, is returned for an invalid index.
+func (atlas FontAtlas) CustomRectByIndex(index int) FontAtlasCustomRect {
+	if (index < 0) || (index >= atlas.CustomRectCount()) {
+		return FontAtlasCustomRect{X: -1, Y: -1}
+	}
+	var rect C.IggFontAtlasCustomRect
+	C.iggFontAtlasGetCustomRectByIndex(atlas.handle(), C.int(index), &rect)
+	return FontAtlasCustomRect{
+		Width:         int(rect.width),
+		Height:        int(rect.height),
+		X:             int(rect.x),
+		Y:             int(rect.y),
+		GlyphID:       rune(rect.glyphID),
+		GlyphAdvanceX: float32(rect.glyphAdvanceX),
+		GlyphOffset:   Vec2{X: float32(rect.glyphOffset.x), Y: float32(rect.glyphOffset.y)},
+		Font:          Font(rect.font),
+	}
+}
+
+// CustomRectUV returns the texture coordinates of the custom rectangle with given index, once the atlas was built.
+func (atlas FontAtlas) CustomRectUV(index int) (uvMin, uvMax Vec2) {
+	if !atlas.CustomRectByIndex(index).IsPacked() {
+		return
+	}
+	uvMinArg, uvMinFin := uvMin.wrapped()
+	uvMaxArg, uvMaxFin := uvMax.wrapped()
+	C.iggFontAtlasCalcCustomRectUV(atlas.handle(), C.int(index), uvMinArg, uvMaxArg)
+	uvMinFin()
+	uvMaxFin()
+	return
+}
@@ -0,0 +1,418 @@
+package imgui
+
+// #include "wrapper/FontAtlasCache.h"
+import "C"
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"unsafe"
+)
+
+// ErrFontAtlasCacheMismatch is returned when loading a built font atlas that was saved with different inputs.
+var ErrFontAtlasCacheMismatch = errors.New("font atlas cache does not match the inputs of the atlas")
+
+var fontAtlasCacheMagic = [8]byte{'I', 'G', 'G', 'A', 'T', 'L', 'A', 'S'}
+
+const fontAtlasCacheVersion = 1
+
+// CacheKey returns a hash of all inputs that determine the result of building the atlas:
+// The font data and configurations, the custom rectangles, the settings of the atlas, and the used font builder.
+// Two atlases with the same key are built identically. As these rectangles include the ones that imgui adds
+// to every atlas, such as for the mouse cursors, they are registered with the atlas if they aren't yet.
+func (atlas FontAtlas) CacheKey() string {
+	key := atlas.cacheKey()
+	return hex.EncodeToString(key[:])
+}
+
+func (atlas FontAtlas) cacheKey() [sha256.Size]byte {
+	C.iggFontAtlasBuildInit(atlas.handle())
+	hash := sha256.New()
+	write := func(value interface{}) {
+		_ = binary.Write(hash, binary.LittleEndian, value)
+	}
+
+	var atlasInputs C.IggFontAtlasInputs
+	C.iggFontAtlasGetInputs(atlas.handle(), &atlasInputs)
+	write([]int64{
+		int64(atlasInputs.version), int64(atlasInputs.wcharSize), int64(atlasInputs.freeType), int64(atlasInputs.flags),
+		int64(atlasInputs.texDesiredWidth), int64(atlasInputs.texGlyphPadding), int64(atlasInputs.fontBuilderFlags),
+	})
+
+	configCount := atlas.configCount()
+	write(int64(configCount))
+	for index := 0; index < configCount; index++ {
+		var inputs C.IggFontConfigInputs
+		C.iggFontAtlasGetConfigInputs(atlas.handle(), C.int(index), &inputs)
+		write([]int64{
+			int64(inputs.fontNo), int64(inputs.oversampleH), int64(inputs.oversampleV), int64(inputs.pixelSnapH),
+			int64(inputs.mergeMode), int64(inputs.fontBuilderFlags), int64(inputs.ellipsisChar), int64(inputs.fontIndex),
+		})
+		write([]float32{
+			float32(inputs.sizePixels), float32(inputs.glyphExtraSpacing.x), float32(inputs.glyphExtraSpacing.y),
+			float32(inputs.glyphOffset.x), float32(inputs.glyphOffset.y), float32(inputs.glyphMinAdvanceX),
+			float32(inputs.glyphMaxAdvanceX), float32(inputs.rasterizerMultiply),
+		})
+		write(int64(inputs.fontDataSize))
+		if inputs.fontDataSize > 0 {
+			hash.Write(ptrToByteSlice(unsafe.Pointer(inputs.fontData))[:inputs.fontDataSize]) // nolint: gas
+		}
+		write(int64(inputs.glyphRangesSize))
+		if inputs.glyphRangesSize > 0 {
+			hash.Write(ptrToByteSlice(unsafe.Pointer(inputs.glyphRanges))[:inputs.glyphRangesSize]) // nolint: gas
+		}
+	}
+
+	fontIndices := make(map[Font]int)
+	for index := 0; index < atlas.FontCount(); index++ {
+		fontIndices[atlas.FontByIndex(index)] = index
+	}
+	rectCount := atlas.CustomRectCount()
+	write(int64(rectCount))
+	for index := 0; index < rectCount; index++ {
+		rect := atlas.CustomRectByIndex(index)
+		fontIndex := -1
+		if rect.Font != 0 {
+			fontIndex = fontIndices[rect.Font]
+		}
+		write([]int64{int64(rect.Width), int64(rect.Height), int64(rect.GlyphID), int64(fontIndex)})
+		write([]float32{rect.GlyphAdvanceX, rect.GlyphOffset.X, rect.GlyphOffset.Y})
+	}
+
+	var key [sha256.Size]byte
+	copy(key[:], hash.Sum(nil))
+	return key
+}
+
+type fontAtlasCacheHeader struct {
+	Magic   [8]byte
+	Version uint32
+	Key     [sha256.Size]byte
+}
+
+type fontAtlasCacheLayout struct {
+	TexWidth      int32
+	TexHeight     int32
+	BytesPerPixel int32
+	UseColors     uint8
+	UVWhitePixel  [2]float32
+	LinesCount    int32
+	RectCount     int32
+	FontCount     int32
+}
+
+type fontAtlasCacheFont struct {
+	FontSize     float32
+	Ascent       float32
+	Descent      float32
+	FallbackChar uint32
+	EllipsisChar int32
+	GlyphCount   int32
+}
+
+type fontAtlasCacheGlyph struct {
+	Codepoint uint32
+	Colored   uint8
+	Visible   uint8
+	AdvanceX  float32
+	Min       [2]float32
+	Max       [2]float32
+	UV0       [2]float32
+	UV1       [2]float32
+}
+
+type fontAtlasCacheData struct {
+	layout fontAtlasCacheLayout
+	lines  [][4]float32
+	rects  [][2]int32
+	fonts  []fontAtlasCacheFont
+	glyphs [][]fontAtlasCacheGlyph
+	pixels []byte
+}
+
+// SaveFontAtlas writes the built atlas to given writer: its texture data, the glyphs and metrics of its fonts,
+// and the positions of its custom rectangles. The data is keyed by CacheKey().
+// The atlas is built if necessary. The texture data must not have been modified since, for example
+// by FontAtlasImages.Rasterize(), as images are written again after loading.
+func SaveFontAtlas(writer io.Writer, atlas FontAtlas) error {
+	if !atlas.IsBuilt() {
+		atlas.Build()
+	}
+	header := fontAtlasCacheHeader{Magic: fontAtlasCacheMagic, Version: fontAtlasCacheVersion, Key: atlas.cacheKey()}
+	if err := binary.Write(writer, binary.LittleEndian, &header); err != nil {
+		return err
+	}
+
+	var layout C.IggFontAtlasLayout
+	C.iggFontAtlasGetLayout(atlas.handle(), &layout)
+	data := fontAtlasCacheData{
+		layout: fontAtlasCacheLayout{
+			TexWidth:      int32(layout.texWidth),
+			TexHeight:     int32(layout.texHeight),
+			BytesPerPixel: int32(layout.bytesPerPixel),
+			UseColors:     uint8(layout.useColors),
+			UVWhitePixel:  [2]float32{float32(layout.uvWhitePixel.x), float32(layout.uvWhitePixel.y)},
+			LinesCount:    int32(C.iggFontAtlasTexUvLinesCount()),
+			RectCount:     int32(atlas.CustomRectCount()),
+			FontCount:     int32(atlas.FontCount()),
+		},
+	}
+	pixelsSize := int(layout.texWidth) * int(layout.texHeight) * int(layout.bytesPerPixel)
+	data.pixels = ptrToByteSlice(layout.pixels)[:pixelsSize]
+
+	lines := make([]C.IggVec4, data.layout.LinesCount)
+	C.iggFontAtlasGetTexUvLines(atlas.handle(), &lines[0])
+	for _, line := range lines {
+		data.lines = append(data.lines, [4]float32{float32(line.x), float32(line.y), float32(line.z), float32(line.w)})
+	}
+	for index := 0; index < int(data.layout.RectCount); index++ {
+		rect := atlas.CustomRectByIndex(index)
+		data.rects = append(data.rects, [2]int32{int32(rect.X), int32(rect.Y)})
+	}
+	for index := 0; index < int(data.layout.FontCount); index++ {
+		font := atlas.FontByIndex(index)
+		glyphs := make([]fontAtlasCacheGlyph, font.GlyphCount())
+		for glyphIndex := range glyphs {
+			glyph := font.GlyphByIndex(glyphIndex)
+			glyphs[glyphIndex] = fontAtlasCacheGlyph{
+				Codepoint: uint32(glyph.Codepoint),
+				Colored:   uint8(castBool(glyph.Colored)),
+				Visible:   uint8(castBool(glyph.Visible)),
+				AdvanceX:  glyph.AdvanceX,
+				Min:       [2]float32{glyph.Min.X, glyph.Min.Y},
+				Max:       [2]float32{glyph.Max.X, glyph.Max.Y},
+				UV0:       [2]float32{glyph.UV0.X, glyph.UV0.Y},
+				UV1:       [2]float32{glyph.UV1.X, glyph.UV1.Y},
+			}
+		}
+		data.fonts = append(data.fonts, fontAtlasCacheFont{
+			FontSize:     font.FontSize(),
+			Ascent:       font.Ascent(),
+			Descent:      font.Descent(),
+			FallbackChar: uint32(font.FallbackChar()),
+			EllipsisChar: int32(font.EllipsisChar()),
+			GlyphCount:   int32(len(glyphs)),
+		})
+		data.glyphs = append(data.glyphs, glyphs)
+	}
+
+	compressed, err := zlib.NewWriterLevel(writer, zlib.BestSpeed)
+	if err != nil {
+		return err
+	}
+	values := []interface{}{&data.layout, data.lines, data.rects}
+	for index := range data.fonts {
+		values = append(values, &data.fonts[index], data.glyphs[index])
+	}
+	values = append(values, data.pixels)
+	for _, value := range values {
+		if err = binary.Write(compressed, binary.LittleEndian, value); err != nil {
+			return err
+		}
+	}
+	return compressed.Close()
+}
+
+// LoadFontAtlas restores the atlas from data written by SaveFontAtlas(), instead of building it.
+// The fonts and custom rectangles have to be added to the atlas as they were when it was saved.
+// If the data was saved with different inputs, ErrFontAtlasCacheMismatch is returned and the atlas is not built.
+// Like CacheKey(), loading registers the custom rectangles that imgui adds to every atlas, as building would.
+func LoadFontAtlas(reader io.Reader, atlas FontAtlas) error {
+	var header fontAtlasCacheHeader
+	if err := binary.Read(reader, binary.LittleEndian, &header); err != nil {
+		return err
+	}
+	if (header.Magic != fontAtlasCacheMagic) || (header.Version != fontAtlasCacheVersion) ||
+		(header.Key != atlas.cacheKey()) {
+		return ErrFontAtlasCacheMismatch
+	}
+	data, err := readFontAtlasCacheData(reader)
+	if err != nil {
+		return err
+	}
+	if (int(data.layout.RectCount) != atlas.CustomRectCount()) || (int(data.layout.FontCount) != atlas.FontCount()) ||
+		(int(data.layout.LinesCount) != int(C.iggFontAtlasTexUvLinesCount())) {
+		return ErrFontAtlasCacheMismatch
+	}
+	data.restore(atlas)
+	return nil
+}
+
+func readFontAtlasCacheData(reader io.Reader) (*fontAtlasCacheData, error) {
+	compressed, err := zlib.NewReader(bufio.NewReader(reader))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = compressed.Close() }()
+	decompressed, err := ioutil.ReadAll(compressed)
+	if err != nil {
+		return nil, err
+	}
+	// The counts are checked against the remaining input before allocating, so that corrupt data can't request
+	// huge allocations.
+	input := bytes.NewReader(decompressed)
+	fits := func(count int64, value interface{}) bool {
+		return (count >= 0) && (count <= int64(input.Len()/binary.Size(value)))
+	}
+	read := func(value interface{}) {
+		if err == nil {
+			err = binary.Read(input, binary.LittleEndian, value)
+		}
+	}
+
+	var data fontAtlasCacheData
+	read(&data.layout)
+	if err != nil {
+		return nil, err
+	}
+	layout := data.layout
+	if (layout.TexWidth <= 0) || (layout.TexHeight <= 0) ||
+		((layout.BytesPerPixel != 1) && (layout.BytesPerPixel != 4)) ||
+		!fits(int64(layout.LinesCount), [4]float32{}) || !fits(int64(layout.RectCount), [2]int32{}) ||
+		!fits(int64(layout.FontCount), fontAtlasCacheFont{}) {
+		return nil, errors.New("invalid font atlas cache layout")
+	}
+	data.lines = make([][4]float32, layout.LinesCount)
+	data.rects = make([][2]int32, layout.RectCount)
+	read(data.lines)
+	read(data.rects)
+	data.fonts = make([]fontAtlasCacheFont, layout.FontCount)
+	data.glyphs = make([][]fontAtlasCacheGlyph, layout.FontCount)
+	for index := range data.fonts {
+		read(&data.fonts[index])
+		if err != nil {
+			return nil, err
+		}
+		if !fits(int64(data.fonts[index].GlyphCount), fontAtlasCacheGlyph{}) {
+			return nil, errors.New("invalid font atlas cache glyph count")
+		}
+		data.glyphs[index] = make([]fontAtlasCacheGlyph, data.fonts[index].GlyphCount)
+		read(data.glyphs[index])
+	}
+	if (err == nil) && !fits(int64(layout.TexWidth)*int64(layout.TexHeight)*int64(layout.BytesPerPixel), byte(0)) {
+		return nil, errors.New("invalid font atlas cache texture size")
+	}
+	if err != nil {
+		return nil, err
+	}
+	data.pixels = make([]byte, int(layout.TexWidth)*int(layout.TexHeight)*int(layout.BytesPerPixel))
+	read(data.pixels)
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+func (data *fontAtlasCacheData) restore(atlas FontAtlas) {
+	layout := C.IggFontAtlasLayout{
+		texWidth:      C.int(data.layout.TexWidth),
+		texHeight:     C.int(data.layout.TexHeight),
+		bytesPerPixel: C.int(data.layout.BytesPerPixel),
+		useColors:     C.IggBool(data.layout.UseColors),
+		uvWhitePixel:  C.IggVec2{x: C.float(data.layout.UVWhitePixel[0]), y: C.float(data.layout.UVWhitePixel[1])},
+	}
+	pixels := C.iggFontAtlasBeginRestore(atlas.handle(), &layout)
+	copy(ptrToByteSlice(pixels)[:len(data.pixels)], data.pixels)
+
+	lines := make([]C.IggVec4, len(data.lines))
+	for index, line := range data.lines {
+		lines[index] = C.IggVec4{x: C.float(line[0]), y: C.float(line[1]), z: C.float(line[2]), w: C.float(line[3])}
+	}
+	if len(lines) > 0 {
+		C.iggFontAtlasRestoreTexUvLines(atlas.handle(), &lines[0])
+	}
+	for index, rect := range data.rects {
+		C.iggFontAtlasRestoreCustomRect(atlas.handle(), C.int(index), C.int(rect[0]), C.int(rect[1]))
+	}
+	for index, metrics := range data.fonts {
+		font := atlas.FontByIndex(index)
+		fontMetrics := C.IggFontMetrics{
+			fontSize:     C.float(metrics.FontSize),
+			ascent:       C.float(metrics.Ascent),
+			descent:      C.float(metrics.Descent),
+			fallbackChar: C.uint(metrics.FallbackChar),
+			ellipsisChar: C.int(metrics.EllipsisChar),
+		}
+		C.iggFontRestoreMetrics(atlas.handle(), font.handle(), &fontMetrics)
+		for _, glyph := range data.glyphs[index] {
+			fontGlyph := C.IggFontGlyph{
+				codepoint: C.uint(glyph.Codepoint),
+				colored:   C.IggBool(glyph.Colored),
+				visible:   C.IggBool(glyph.Visible),
+				advanceX:  C.float(glyph.AdvanceX),
+				min:       C.IggVec2{x: C.float(glyph.Min[0]), y: C.float(glyph.Min[1])},
+				max:       C.IggVec2{x: C.float(glyph.Max[0]), y: C.float(glyph.Max[1])},
+				uv0:       C.IggVec2{x: C.float(glyph.UV0[0]), y: C.float(glyph.UV0[1])},
+				uv1:       C.IggVec2{x: C.float(glyph.UV1[0]), y: C.float(glyph.UV1[1])},
+			}
+			C.iggFontRestoreGlyph(font.handle(), &fontGlyph)
+		}
+	}
+	C.iggFontAtlasEndRestore(atlas.handle())
+}
+
+// FontAtlasCache keeps built font atlases in a directory, one file per CacheKey().
+//
+// Usage:
+//   io.Fonts().AddFontFromFileTTF("NotoSansCJK.ttc", 18)
+//   cache := imgui.NewFontAtlasCache(filepath.Join(userCacheDir, "fonts"))
+//   if _, err := cache.Build(io.Fonts()); err != nil {
+//       log.Printf("font atlas not cached: %v", err)
+//   }
+//   renderer, _ := imgui.NewOpenGL3(io, 1)
+type FontAtlasCache struct {
+	dir string
+}
+
+// NewFontAtlasCache returns a cache that stores its files in given directory.
+// The directory is created when the first atlas is saved.
+func NewFontAtlasCache(dir string) *FontAtlasCache {
+	return &FontAtlasCache{dir: dir}
+}
+
+// Path returns the path of the file for given atlas.
+func (cache *FontAtlasCache) Path(atlas FontAtlas) string {
+	return filepath.Join(cache.dir, atlas.CacheKey()+".atlas")
+}
+
+// Build loads the atlas from the cache if a file for its inputs exists, or builds and saves it otherwise.
+// It returns true if the atlas was loaded. If an error is returned, the atlas was still built, but could not be saved.
+func (cache *FontAtlasCache) Build(atlas FontAtlas) (loaded bool, err error) {
+	path := cache.Path(atlas)
+	if file, openErr := os.Open(path); openErr == nil {
+		loadErr := LoadFontAtlas(file, atlas)
+		_ = file.Close()
+		if loadErr == nil {
+			return true, nil
+		}
+	}
+
+	atlas.Build()
+	if err = os.MkdirAll(cache.dir, 0755); err != nil {
+		return false, err
+	}
+	file, err := ioutil.TempFile(cache.dir, "*.tmp")
+	if err != nil {
+		return false, err
+	}
+	err = SaveFontAtlas(file, atlas)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+	}
+	return false, err
+}
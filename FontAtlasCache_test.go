@@ -0,0 +1,125 @@
+package imgui_test
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/ianling/imgui-go"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func addCacheTestFont(atlas imgui.FontAtlas, size float32) imgui.Font {
+	config := imgui.NewFontConfig()
+	defer config.Delete()
+	config.SetSize(size)
+	return atlas.AddFontDefaultV(config)
+}
+
+func TestFontAtlasSaveAndLoad(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	atlas := imgui.CurrentIO().Fonts()
+	font := addCacheTestFont(atlas, 20)
+	var buffer bytes.Buffer
+	require.Nil(t, imgui.SaveFontAtlas(&buffer, atlas))
+	key := atlas.CacheKey()
+	original := atlas.TextureDataAlpha8()
+	originalPixels := append([]byte(nil), (*[1 << 30]byte)(original.Pixels)[:original.Width*original.Height]...)
+	originalGlyph := font.FindGlyph('A')
+	context.Destroy()
+
+	context = imgui.CreateContext(nil)
+	defer context.Destroy()
+	atlas = imgui.CurrentIO().Fonts()
+	font = addCacheTestFont(atlas, 20)
+	assert.Equal(t, key, atlas.CacheKey(), "Key should not depend on previous builds")
+	require.Nil(t, imgui.LoadFontAtlas(bytes.NewReader(buffer.Bytes()), atlas))
+	assert.True(t, atlas.IsBuilt())
+
+	restored := atlas.TextureDataAlpha8()
+	require.Equal(t, original.Width, restored.Width)
+	require.Equal(t, original.Height, restored.Height)
+	assert.Equal(t, originalPixels, (*[1 << 30]byte)(restored.Pixels)[:restored.Width*restored.Height])
+	assert.Equal(t, originalGlyph, font.FindGlyph('A'))
+	assert.Equal(t, float32(20), font.FontSize())
+	assert.Equal(t, originalGlyph.AdvanceX, font.CharAdvance('A'))
+}
+
+func TestFontAtlasLoadMismatch(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+	atlas := imgui.CurrentIO().Fonts()
+	addCacheTestFont(atlas, 20)
+	var buffer bytes.Buffer
+	require.Nil(t, imgui.SaveFontAtlas(&buffer, atlas))
+
+	atlas.Clear()
+	addCacheTestFont(atlas, 21)
+	assert.Equal(t, imgui.ErrFontAtlasCacheMismatch, imgui.LoadFontAtlas(bytes.NewReader(buffer.Bytes()), atlas))
+}
+
+func TestFontAtlasLoadRejectsCorruptCounts(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+	atlas := imgui.CurrentIO().Fonts()
+	addCacheTestFont(atlas, 20)
+	var saved bytes.Buffer
+	require.Nil(t, imgui.SaveFontAtlas(&saved, atlas))
+	const headerSize = 8 + 4 + 32
+
+	tt := []struct {
+		name                             string
+		width, height                    int32
+		linesCount, rectCount, fontCount int32
+	}{
+		{name: "lines", width: 1, height: 1, linesCount: 1 << 30},
+		{name: "rects", width: 1, height: 1, rectCount: -1},
+		{name: "fonts", width: 1, height: 1, fontCount: 1 << 30},
+		{name: "texture", width: 1 << 30, height: 1 << 30},
+	}
+	for _, tc := range tt {
+		td := tc
+		t.Run(td.name, func(t *testing.T) {
+			data := bytes.NewBuffer(append([]byte(nil), saved.Bytes()[:headerSize]...))
+			compressed := zlib.NewWriter(data)
+			layout := struct {
+				TexWidth, TexHeight, BytesPerPixel int32
+				UseColors                          uint8
+				UVWhitePixel                       [2]float32
+				LinesCount, RectCount, FontCount   int32
+			}{TexWidth: td.width, TexHeight: td.height, BytesPerPixel: 1,
+				LinesCount: td.linesCount, RectCount: td.rectCount, FontCount: td.fontCount}
+			require.Nil(t, binary.Write(compressed, binary.LittleEndian, &layout))
+			require.Nil(t, compressed.Close())
+
+			err := imgui.LoadFontAtlas(data, atlas)
+			assert.NotNil(t, err, "Counts beyond the input should be rejected")
+			assert.NotEqual(t, imgui.ErrFontAtlasCacheMismatch, err)
+		})
+	}
+}
+
+func TestFontAtlasCacheBuild(t *testing.T) {
+	dir, err := ioutil.TempDir("", "imgui-font-cache")
+	require.Nil(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	cache := imgui.NewFontAtlasCache(dir)
+
+	for _, expectLoaded := range []bool{false, true} {
+		context := imgui.CreateContext(nil)
+		atlas := imgui.CurrentIO().Fonts()
+		addCacheTestFont(atlas, 16)
+		loaded, err := cache.Build(atlas)
+		assert.Nil(t, err)
+		assert.Equal(t, expectLoaded, loaded)
+		assert.True(t, atlas.IsBuilt())
+		_, statErr := os.Stat(cache.Path(atlas))
+		assert.Nil(t, statErr, "Cache file should exist")
+		context.Destroy()
+	}
+}
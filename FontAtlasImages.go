@@ -0,0 +1,163 @@
+package imgui
+
+import (
+	"image"
+	"image/color"
+	"math"
+)
+
+// FontAtlasImages rasterizes images into custom rectangles of a font atlas.
+//
+// Images added as glyphs can be used inline in text, typically with codepoints of the Unicode private use area
+// (U+E000 to U+F8FF). Regular images can be drawn with Image(), using the texture of the atlas and CustomRectUV().
+// Images are scaled to the requested size, which is in turn scaled by DPIScale, as are fonts that are added to the atlas.
+//
+// The rectangles are packed when the atlas is built, and the pixels are written with Rasterize().
+// As building the atlas clears the texture data, Rasterize() must be called again after every rebuild of the atlas,
+// before the texture is uploaded to the renderer. Renderers do this when the images are added as FontAtlasRasterizer,
+// for example with OpenGL3.AddFontRasterizer(). The renderer has to use TextureDataRGBA32() for colors to be kept.
+//
+// Usage:
+//   images := imgui.NewFontAtlasImages(io.Fonts())
+//   font := io.Fonts().AddFontFromFileTTF("Roboto.ttf", 16)
+//   images.AddGlyph(font, 0xE000, saveIcon, 16, 16)
+//   renderer.AddFontRasterizer(images)
+//   ...
+//   imgui.Text("\uE000 Save")
+type FontAtlasImages struct {
+	atlas   FontAtlas
+	entries []fontAtlasImage
+}
+
+type fontAtlasImage struct {
+	rectIndex int
+	source    image.Image
+}
+
+// NewFontAtlasImages returns a helper for given atlas.
+func NewFontAtlasImages(atlas FontAtlas) *FontAtlasImages {
+	return &FontAtlasImages{atlas: atlas}
+}
+
+// AddGlyph adds the image as glyph of given character to the font, which must already be added to the atlas.
+// DefaultFont is resolved as described for FontAtlas.AddCustomRectFontGlyph().
+// The glyph has given size in pixels; a zero width or height is derived from the aspect ratio of the image,
+// or from its bounds if both are zero. The glyph is vertically centered on the line and advances by its width plus one
+// pixel. Use AddGlyphV() for control over the placement.
+// It returns the index of the custom rectangle within the atlas, or -1 if the atlas has no font.
+func (images *FontAtlasImages) AddGlyph(font Font, codepoint rune, source image.Image, width, height int) int {
+	font = images.atlas.fontOrDefault(font)
+	if font == DefaultFont {
+		return -1
+	}
+	width, height = images.scaledSize(source, width, height)
+	offsetY := (font.FontSize() - float32(height)) / 2
+	return images.addGlyph(font, codepoint, source, width, height, float32(width+1), Vec2{Y: offsetY})
+}
+
+// AddGlyphV adds the image as glyph of given character to the font, which must already be added to the atlas.
+// The glyph has given size in pixels, and is positioned at offset relative to the pen position at the top of the line.
+// The pen advances by advanceX. The size, offset and advance are scaled by DPIScale.
+// It returns the index of the custom rectangle within the atlas, or -1 if the atlas has no font.
+func (images *FontAtlasImages) AddGlyphV(font Font, codepoint rune, source image.Image,
+	width, height int, advanceX float32, offset Vec2) int {
+	width, height = images.scaledSize(source, width, height)
+	scale := images.scale()
+	return images.addGlyph(font, codepoint, source, width, height, advanceX*scale, offset.Times(scale))
+}
+
+func (images *FontAtlasImages) addGlyph(font Font, codepoint rune, source image.Image,
+	width, height int, advanceX float32, offset Vec2) int {
+	index := images.atlas.AddCustomRectFontGlyph(font, codepoint, width, height, advanceX, offset)
+	if index < 0 {
+		return index
+	}
+	images.entries = append(images.entries, fontAtlasImage{rectIndex: index, source: source})
+	return index
+}
+
+// AddRegular adds the image as a rectangle that is not bound to a font, with the same sizing as for AddGlyph().
+// It returns the index of the custom rectangle within the atlas.
+func (images *FontAtlasImages) AddRegular(source image.Image, width, height int) int {
+	width, height = images.scaledSize(source, width, height)
+	index := images.atlas.AddCustomRectRegular(width, height)
+	images.entries = append(images.entries, fontAtlasImage{rectIndex: index, source: source})
+	return index
+}
+
+func (images *FontAtlasImages) scale() float32 {
+	if DPIScale > 0 {
+		return DPIScale
+	}
+	return 1
+}
+
+func (images *FontAtlasImages) scaledSize(source image.Image, width, height int) (int, int) {
+	bounds := source.Bounds()
+	switch {
+	case (width <= 0) && (height <= 0):
+		width, height = bounds.Dx(), bounds.Dy()
+	case width <= 0:
+		width = int(math.Round(float64(height*bounds.Dx()) / float64(bounds.Dy())))
+	case height <= 0:
+		height = int(math.Round(float64(width*bounds.Dy()) / float64(bounds.Dx())))
+	}
+	scale := images.scale()
+	width = int(math.Round(float64(float32(width) * scale)))
+	height = int(math.Round(float64(float32(height) * scale)))
+	if width < 1 {
+		width = 1
+	}
+	if height < 1 {
+		height = 1
+	}
+	return width, height
+}
+
+// Rasterize writes all added images into the texture data of the atlas, building the atlas if necessary.
+// It returns the texture data, ready to be uploaded.
+func (images *FontAtlasImages) Rasterize() *RGBA32Image {
+	texture := images.atlas.TextureDataRGBA32()
+	pixels := ptrToByteSlice(texture.Pixels)[:texture.Width*texture.Height*4]
+	for _, entry := range images.entries {
+		rect := images.atlas.CustomRectByIndex(entry.rectIndex)
+		if !rect.IsPacked() {
+			continue
+		}
+		drawScaledImage(pixels, texture.Width, rect, entry.source)
+	}
+	return texture
+}
+
+// drawScaledImage writes the source image into the rectangle of the RGBA texture, which is not premultiplied.
+// Each target pixel is the average of a grid of samples of the source area it covers.
+func drawScaledImage(pixels []byte, stride int, rect FontAtlasCustomRect, source image.Image) {
+	bounds := source.Bounds()
+	scaleX := float64(bounds.Dx()) / float64(rect.Width)
+	scaleY := float64(bounds.Dy()) / float64(rect.Height)
+	samplesX := int(math.Max(1, math.Ceil(scaleX)))
+	samplesY := int(math.Max(1, math.Ceil(scaleY)))
+	for y := 0; y < rect.Height; y++ {
+		for x := 0; x < rect.Width; x++ {
+			var r, g, b, a uint64
+			for sy := 0; sy < samplesY; sy++ {
+				srcY := bounds.Min.Y + int((float64(y)+(float64(sy)+0.5)/float64(samplesY))*scaleY)
+				for sx := 0; sx < samplesX; sx++ {
+					srcX := bounds.Min.X + int((float64(x)+(float64(sx)+0.5)/float64(samplesX))*scaleX)
+					sr, sg, sb, sa := source.At(srcX, srcY).RGBA()
+					r, g, b, a = r+uint64(sr), g+uint64(sg), b+uint64(sb), a+uint64(sa)
+				}
+			}
+			count := uint64(samplesX * samplesY)
+			premultiplied := color.RGBA64{
+				R: uint16(r / count), G: uint16(g / count), B: uint16(b / count), A: uint16(a / count),
+			}
+			target := color.NRGBAModel.Convert(premultiplied).(color.NRGBA)
+			offset := ((rect.Y+y)*stride + rect.X + x) * 4
+			pixels[offset+0] = target.R
+			pixels[offset+1] = target.G
+			pixels[offset+2] = target.B
+			pixels[offset+3] = target.A
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package imgui_test
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/ianling/imgui-go"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFontAtlasImagesRasterizesGlyph(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+
+	atlas := imgui.CurrentIO().Fonts()
+	font := atlas.AddFontDefault()
+	icon := image.NewNRGBA(image.Rect(0, 0, 32, 32))
+	for i := range icon.Pix {
+		icon.Pix[i] = 0xFF
+	}
+	icon.Set(0, 0, color.NRGBA{R: 0xFF, A: 0xFF})
+	icon.Set(1, 0, color.NRGBA{R: 0xFF, A: 0xFF})
+	icon.Set(0, 1, color.NRGBA{R: 0xFF, A: 0xFF})
+	icon.Set(1, 1, color.NRGBA{R: 0xFF, A: 0xFF})
+
+	images := imgui.NewFontAtlasImages(atlas)
+	index := images.AddGlyph(font, 0xE000, icon, 16, 0)
+	texture := images.Rasterize()
+
+	rect := atlas.CustomRectByIndex(index)
+	assert.True(t, rect.IsPacked())
+	assert.Equal(t, 16, rect.Width)
+	assert.Equal(t, 16, rect.Height)
+	assert.Equal(t, rune(0xE000), rect.GlyphID)
+
+	pixels := (*[1 << 30]byte)(texture.Pixels)[: texture.Width*texture.Height*4 : texture.Width*texture.Height*4]
+	pixelAt := func(x, y int) []byte {
+		offset := ((rect.Y+y)*texture.Width + rect.X + x) * 4
+		return pixels[offset : offset+4]
+	}
+	assert.Equal(t, []byte{0xFF, 0x00, 0x00, 0xFF}, pixelAt(0, 0), "Scaled down corner should be red")
+	assert.Equal(t, []byte{0xFF, 0xFF, 0xFF, 0xFF}, pixelAt(8, 8))
+
+	glyph, found := font.FindGlyphNoFallback(0xE000)
+	assert.True(t, found, "Glyph should be added to font")
+	assert.Equal(t, float32(17), glyph.AdvanceX)
+	uvMin, uvMax := atlas.CustomRectUV(index)
+	assert.Equal(t, uvMin, glyph.UV0)
+	assert.Equal(t, uvMax, glyph.UV1)
+}
+
+func TestFontAtlasImagesDefaultFont(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+
+	atlas := imgui.CurrentIO().Fonts()
+	icon := image.NewNRGBA(image.Rect(0, 0, 8, 8))
+	images := imgui.NewFontAtlasImages(atlas)
+	assert.Equal(t, -1, images.AddGlyph(imgui.DefaultFont, 0xE000, icon, 0, 0), "Atlas without font should be rejected")
+
+	first := atlas.AddFontDefault()
+	second := atlas.AddFontDefault()
+	index := images.AddGlyph(imgui.DefaultFont, 0xE000, icon, 0, 0)
+	images.Rasterize()
+
+	assert.True(t, atlas.CustomRectByIndex(index).IsPacked())
+	_, found := first.FindGlyphNoFallback(0xE000)
+	assert.True(t, found, "Default font should be the first font of the atlas")
+	_, found = second.FindGlyphNoFallback(0xE000)
+	assert.False(t, found)
+}
@@ -0,0 +1,195 @@
+package imgui
+
+import "math"
+
+// TextureIDSignedDistanceField is a flag of a TextureID, marking a texture that holds signed distance fields
+// in its alpha channel. Renderers that support this flag draw such textures with a shader that keeps the edges
+// of glyphs crisp at any scale; they have to remove the flag to get the actual texture.
+const TextureIDSignedDistanceField TextureID = 1 << 31
+
+// FontAtlasSDF converts the glyphs of a font atlas into signed distance fields.
+//
+// A distance field stores for each pixel the distance to the nearest edge of the glyph, instead of its coverage.
+// Rendered with a matching shader, text then stays crisp when it is scaled, for example with IO.SetFontGlobalScale()
+// or by zooming a canvas. Fonts should be added with a size close to the largest one they are displayed at,
+// as details smaller than a pixel of the atlas are lost.
+//
+// Only the glyphs of fonts are converted. Colored glyphs are kept, as are other parts of the atlas, such as
+// the white pixel used for shapes. Images added with FontAtlasImages are drawn with sharp edges.
+// As baked lines would lose their anti-aliasing, these are disabled for the atlas.
+//
+// Usage:
+//   sdf := imgui.NewFontAtlasSDF(io.Fonts(), 4)
+//   io.Fonts().AddFontFromFileTTF("Roboto.ttf", 48)
+//   renderer, _ := imgui.NewOpenGL3(io, 1)
+//   renderer.SetFontAtlasSDF(sdf)
+type FontAtlasSDF struct {
+	atlas  FontAtlas
+	spread int
+}
+
+// NewFontAtlasSDF prepares given atlas for distance fields that extend given number of pixels beyond the edges
+// of glyphs. The spread must be at least 1; larger values allow for effects such as outlines, at the cost
+// of a larger texture. The padding between glyphs of the atlas is increased to make room for the spread.
+func NewFontAtlasSDF(atlas FontAtlas, spread int) *FontAtlasSDF {
+	if spread < 1 {
+		spread = 1
+	}
+	if padding := 2*spread + 1; atlas.TexGlyphPadding() < padding {
+		atlas.SetTexGlyphPadding(padding)
+	}
+	atlas.SetFlags(atlas.Flags() | FontAtlasFlagsNoBakedLines)
+	return &FontAtlasSDF{atlas: atlas, spread: spread}
+}
+
+// Atlas returns the font atlas of the distance fields.
+func (sdf *FontAtlasSDF) Atlas() FontAtlas {
+	return sdf.atlas
+}
+
+// Spread returns the number of pixels the distance fields extend beyond the edges of glyphs.
+func (sdf *FontAtlasSDF) Spread() int {
+	return sdf.spread
+}
+
+// Build builds the atlas and converts its glyphs into distance fields.
+// It returns the texture data, ready to be uploaded. The texture ID set in the atlas should have
+// the flag TextureIDSignedDistanceField.
+//
+// The alpha value of a pixel is 0.5 on the edge of a glyph, and increases by 0.5 / Spread() for each
+// pixel towards the inside.
+func (sdf *FontAtlasSDF) Build() *RGBA32Image {
+	sdf.atlas.Build()
+	texture := sdf.atlas.TextureDataRGBA32()
+	pixels := ptrToByteSlice(texture.Pixels)[:texture.Width*texture.Height*4]
+	for fontIndex := 0; fontIndex < sdf.atlas.FontCount(); fontIndex++ {
+		font := sdf.atlas.FontByIndex(fontIndex)
+		for glyphIndex := 0; glyphIndex < font.GlyphCount(); glyphIndex++ {
+			glyph := font.GlyphByIndex(glyphIndex)
+			if !glyph.Visible || glyph.Colored {
+				continue
+			}
+			sdf.convertGlyph(pixels, texture.Width, texture.Height, glyph)
+		}
+	}
+	return texture
+}
+
+func (sdf *FontAtlasSDF) convertGlyph(pixels []byte, textureWidth, textureHeight int, glyph FontGlyph) {
+	minX := int(math.Round(float64(glyph.UV0.X*float32(textureWidth)))) - sdf.spread
+	minY := int(math.Round(float64(glyph.UV0.Y*float32(textureHeight)))) - sdf.spread
+	maxX := int(math.Round(float64(glyph.UV1.X*float32(textureWidth)))) + sdf.spread
+	maxY := int(math.Round(float64(glyph.UV1.Y*float32(textureHeight)))) + sdf.spread
+	if minX < 0 {
+		minX = 0
+	}
+	if minY < 0 {
+		minY = 0
+	}
+	if maxX > textureWidth {
+		maxX = textureWidth
+	}
+	if maxY > textureHeight {
+		maxY = textureHeight
+	}
+	width, height := maxX-minX, maxY-minY
+	if (width <= 0) || (height <= 0) {
+		return
+	}
+
+	coverage := make([]byte, width*height)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			coverage[y*width+x] = pixels[((minY+y)*textureWidth+minX+x)*4+3]
+		}
+	}
+	distances := signedDistanceField(coverage, width, height)
+	scale := 0.5 / float64(sdf.spread)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			value := 0.5 + distances[y*width+x]*scale
+			pixels[((minY+y)*textureWidth+minX+x)*4+3] = byte(math.Round(math.Max(0, math.Min(1, value)) * 255))
+		}
+	}
+}
+
+// signedDistanceField returns for each pixel of the coverage image the distance to the nearest edge,
+// positive inside and negative outside. Pixels with a coverage of at least half are inside.
+// Partially covered pixels on the edge derive their distance from the coverage.
+func signedDistanceField(coverage []byte, width, height int) []float64 {
+	inside := make([]bool, len(coverage))
+	outside := make([]bool, len(coverage))
+	for index, value := range coverage {
+		inside[index] = value >= 0x80
+		outside[index] = !inside[index]
+	}
+	toInside := distanceTransform(inside, width, height)
+	toOutside := distanceTransform(outside, width, height)
+
+	distances := make([]float64, len(coverage))
+	for index, value := range coverage {
+		switch {
+		case (value > 0) && (value < 0xFF) && ((toInside[index] <= 1) && (toOutside[index] <= 1)):
+			distances[index] = float64(value)/0xFF - 0.5
+		case inside[index]:
+			distances[index] = toOutside[index] - 0.5
+		default:
+			distances[index] = -(toInside[index] - 0.5)
+		}
+	}
+	return distances
+}
+
+// distanceTransform returns for each pixel the euclidean distance to the nearest set pixel, using
+// the 8-point sequential signed euclidean distance transform. Set pixels have a distance of zero.
+func distanceTransform(set []bool, width, height int) []float64 {
+	const far = 1 << 14
+	type offset struct{ x, y int }
+	grid := make([]offset, len(set))
+	for index, isSet := range set {
+		if !isSet {
+			grid[index] = offset{far, far}
+		}
+	}
+	lengthSquared := func(o offset) int { return o.x*o.x + o.y*o.y }
+	compare := func(x, y, dx, dy int) {
+		nx, ny := x+dx, y+dy
+		if (nx < 0) || (ny < 0) || (nx >= width) || (ny >= height) {
+			return
+		}
+		other := grid[ny*width+nx]
+		candidate := offset{other.x + dx, other.y + dy}
+		if lengthSquared(candidate) < lengthSquared(grid[y*width+x]) {
+			grid[y*width+x] = candidate
+		}
+	}
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			compare(x, y, -1, 0)
+			compare(x, y, 0, -1)
+			compare(x, y, -1, -1)
+			compare(x, y, 1, -1)
+		}
+		for x := width - 1; x >= 0; x-- {
+			compare(x, y, 1, 0)
+		}
+	}
+	for y := height - 1; y >= 0; y-- {
+		for x := width - 1; x >= 0; x-- {
+			compare(x, y, 1, 0)
+			compare(x, y, 0, 1)
+			compare(x, y, -1, 1)
+			compare(x, y, 1, 1)
+		}
+		for x := 0; x < width; x++ {
+			compare(x, y, -1, 0)
+		}
+	}
+
+	distances := make([]float64, len(set))
+	for index, o := range grid {
+		distances[index] = math.Sqrt(float64(lengthSquared(o)))
+	}
+	return distances
+}
@@ -0,0 +1,48 @@
+package imgui_test
+
+import (
+	"testing"
+
+	"github.com/ianling/imgui-go"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFontAtlasSDFConvertsGlyphs(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+
+	atlas := imgui.CurrentIO().Fonts()
+	sdf := imgui.NewFontAtlasSDF(atlas, 3)
+	assert.Equal(t, 7, atlas.TexGlyphPadding(), "Padding should make room for the spread")
+	assert.NotEqual(t, imgui.FontAtlasFlagsNone, atlas.Flags()&imgui.FontAtlasFlagsNoBakedLines)
+	config := imgui.NewFontConfig()
+	defer config.Delete()
+	config.SetSize(26)
+	font := atlas.AddFontDefaultV(config)
+
+	texture := sdf.Build()
+	require.Equal(t, 1, atlas.FontCount())
+	assert.Equal(t, font, atlas.FontByIndex(0))
+	assert.True(t, font.GlyphCount() > 90, "Font should contain the default glyphs")
+
+	glyph, found := font.FindGlyphNoFallback('I')
+	require.True(t, found)
+	pixels := (*[1 << 30]byte)(texture.Pixels)[: texture.Width*texture.Height*4 : texture.Width*texture.Height*4]
+	minX, minY := int(glyph.UV0.X*float32(texture.Width)), int(glyph.UV0.Y*float32(texture.Height))
+	maxX, maxY := int(glyph.UV1.X*float32(texture.Width)), int(glyph.UV1.Y*float32(texture.Height))
+	levels := make(map[byte]bool)
+	for y := minY - 3; y < maxY+3; y++ {
+		for x := minX - 3; x < maxX+3; x++ {
+			levels[pixels[(y*texture.Width+x)*4+3]] = true
+		}
+	}
+	assert.True(t, len(levels) > 4, "Distance field should have a gradient")
+	assert.True(t, levels[0], "Distance field should fade out within the spread")
+
+	centerX := (minX + maxX) / 2
+	centerY := (minY + maxY) / 2
+	assert.True(t, pixels[(centerY*texture.Width+centerX)*4+3] > 0x80, "Center of glyph should be inside")
+	assert.True(t, pixels[((minY-2)*texture.Width+centerX)*4+3] < 0x80, "Spread above glyph should be outside")
+}
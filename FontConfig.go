@@ -64,6 +64,13 @@ func (config FontConfig) SetPixelSnapH(value bool) {
 	}
 }
 
+// SetFontNo sets the index of the font within a font collection file (.ttc), which contains several fonts.
+func (config FontConfig) SetFontNo(value int) {
+	if config != DefaultFontConfig {
+		C.iggFontConfigSetFontNo(config.handle(), C.int(value))
+	}
+}
+
 // SetGlyphMinAdvanceX sets the minimum AdvanceX for glyphs.
 // Set Min to align font icons, set both Min/Max to enforce mono-space font.
 func (config FontConfig) SetGlyphMinAdvanceX(value float32) {
@@ -107,6 +114,14 @@ func (config FontConfig) SetMergeMode(value bool) {
 	}
 }
 
+// SetGlyphRanges sets the glyph ranges to load from the font, used by functions that take no ranges,
+// such as FontAtlas.AddFontDefaultV(). The ranges must stay valid for as long as the atlas uses them.
+func (config FontConfig) SetGlyphRanges(ranges GlyphRanges) {
+	if config != DefaultFontConfig {
+		C.iggFontConfigSetGlyphRanges(config.handle(), ranges.handle())
+	}
+}
+
 // SetName sets a short display name for a font, for diagnostic purposes.
 // If the FontConfig does not provide a name, one will be synthesized for
 // fonts which are added from files.  When adding fonts from memory, this
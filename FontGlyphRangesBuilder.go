@@ -14,6 +14,15 @@ func (ranges GlyphRanges) Data() GlyphRanges {
     return GlyphRanges(C.IggGlyphRangesData(ranges.handle()))
 }
 
+// Delete frees ranges that were created with NewGlyphRanges() and resets them to EmptyGlyphRanges.
+// The ranges must no longer be referenced by any font of an atlas.
+func (ranges *GlyphRanges) Delete() {
+    if *ranges != EmptyGlyphRanges {
+        C.IggDeleteGlyphRanges(ranges.handle())
+        *ranges = EmptyGlyphRanges
+    }
+}
+
 func NewFontGlyphRangesBuilder() FontGlyphRangesBuilder {
     handle := C.IggNewFontGlyphRangesBuilder()
     return FontGlyphRangesBuilder(handle)
@@ -30,6 +39,25 @@ func (builder FontGlyphRangesBuilder) AddText(text string) {
     C.IggFontGlyphRangesBuilderAddText(builder.handle(), textArg)
 }
 
+// HasChar returns true if the given character was added.
+func (builder FontGlyphRangesBuilder) HasChar(c rune) bool {
+    return C.IggFontGlyphRangesBuilderHasChar(builder.handle(), C.uint(c)) != 0
+}
+
+// AddChar adds a single character. Characters beyond the range supported by the build of imgui are ignored.
+func (builder FontGlyphRangesBuilder) AddChar(c rune) {
+    C.IggFontGlyphRangesBuilderAddChar(builder.handle(), C.uint(c))
+}
+
+// AddRange adds all characters from first to last, inclusive.
+// Characters beyond the range supported by the build of imgui are ignored.
+func (builder FontGlyphRangesBuilder) AddRange(first, last rune) {
+    if first > last {
+        return
+    }
+    C.IggFontGlyphRangesBuilderAddRange(builder.handle(), C.uint(first), C.uint(last))
+}
+
 func (builder FontGlyphRangesBuilder) AddRanges(ranges GlyphRanges) {
     C.IggFontGlyphRangesBuilderAddRanges(builder.handle(), C.IggGlyphRanges(ranges))
 }
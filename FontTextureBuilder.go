@@ -0,0 +1,100 @@
+package imgui
+
+// FontAtlasRasterizer writes pixel data into the texture data of a font atlas after the atlas was built,
+// such as the images of custom rectangles. FontAtlasImages and the fonts of the bmfont package implement it.
+type FontAtlasRasterizer interface {
+	// Rasterize writes into the RGBA32 texture data of the atlas and returns it.
+	Rasterize() *RGBA32Image
+}
+
+// FontTextureBuilder prepares the texture data of a font atlas for upload by a renderer.
+//
+// Building the atlas clears its texture data, which loses any pixels written into custom rectangles.
+// The builder therefore calls its rasterizers after every build, right before the texture data is returned
+// for upload. It also keeps track of rebuilds that were requested while a frame is in progress, so that a
+// renderer can apply them at a safe point between frames.
+//
+// Renderers without their own handling can use it like this:
+//   if builder.ApplyRequestedRebuild(atlas) {
+//       // release the old texture
+//       image := builder.TextureData(atlas, nil)
+//       // upload image and set the texture ID in the atlas
+//   }
+//
+// The zero value is ready to use.
+type FontTextureBuilder struct {
+	rebuildRequested bool
+	rebuildUpdates   []func(atlas FontAtlas)
+	rasterizers      []FontAtlasRasterizer
+}
+
+// AddRasterizer adds a rasterizer that is called after each build of the atlas, in the order they were added.
+func (builder *FontTextureBuilder) AddRasterizer(rasterizer FontAtlasRasterizer) {
+	builder.rasterizers = append(builder.rasterizers, rasterizer)
+}
+
+// RequestRebuild requests the atlas to be rebuilt with the next call to ApplyRequestedRebuild().
+// The optional update function is called right before the atlas is built. If RequestRebuild is called several
+// times before, all update functions are called in order.
+func (builder *FontTextureBuilder) RequestRebuild(update func(atlas FontAtlas)) {
+	builder.rebuildRequested = true
+	if update != nil {
+		builder.rebuildUpdates = append(builder.rebuildUpdates, update)
+	}
+}
+
+// RebuildRequested returns true if a rebuild was requested that was not applied yet.
+func (builder *FontTextureBuilder) RebuildRequested() bool {
+	return builder.rebuildRequested
+}
+
+// ApplyRequestedRebuild calls the pending update functions and clears the texture data of the atlas,
+// if a rebuild was requested. It returns true in this case, and the texture has to be uploaded again
+// with the data of TextureData().
+func (builder *FontTextureBuilder) ApplyRequestedRebuild(atlas FontAtlas) bool {
+	if !builder.rebuildRequested {
+		return false
+	}
+	updates := builder.rebuildUpdates
+	builder.rebuildRequested = false
+	builder.rebuildUpdates = nil
+
+	for _, update := range updates {
+		update(atlas)
+	}
+	// Clearing the texture data forces a build with the current fonts, adding the default font if there are none.
+	atlas.ClearTexData()
+	return true
+}
+
+// TextureData builds the atlas if necessary and returns its RGBA32 texture data, with the pixels of all
+// rasterizers written into it. If sdf is not nil, the atlas is built with it, and the rasterizers are called
+// after the glyphs were converted into distance fields.
+func (builder *FontTextureBuilder) TextureData(atlas FontAtlas, sdf *FontAtlasSDF) *RGBA32Image {
+	var image *RGBA32Image
+	if sdf != nil {
+		image = sdf.Build()
+	} else {
+		image = atlas.TextureDataRGBA32()
+	}
+	for _, rasterizer := range builder.rasterizers {
+		rasterizer.Rasterize()
+	}
+	if atlas.TexPixelsUseColors() {
+		// Atlases with color glyphs are cleared to transparent black, which darkens the edges
+		// of the white glyphs with linear filtering. Imgui itself clears to transparent white.
+		whitenTransparentPixels(ptrToByteSlice(image.Pixels)[:image.Width*image.Height*4])
+	}
+	return image
+}
+
+// whitenTransparentPixels sets the color of all fully transparent pixels of the non-premultiplied RGBA data to white.
+func whitenTransparentPixels(pixels []byte) {
+	for offset := 0; offset+3 < len(pixels); offset += 4 {
+		if pixels[offset+3] == 0 {
+			pixels[offset+0] = 0xFF
+			pixels[offset+1] = 0xFF
+			pixels[offset+2] = 0xFF
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package imgui_test
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+
+	"github.com/ianling/imgui-go"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFontTextureBuilderRasterizesAfterRebuild(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+
+	atlas := imgui.CurrentIO().Fonts()
+	atlas.AddFontDefault()
+	icon := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	draw.Draw(icon, icon.Bounds(), image.NewUniform(color.NRGBA{R: 0xFF, A: 0xFF}), image.Point{}, draw.Src)
+	images := imgui.NewFontAtlasImages(atlas)
+	red := images.AddRegular(icon, 4, 4)
+
+	var builder imgui.FontTextureBuilder
+	builder.AddRasterizer(images)
+	pixelAt := func(texture *imgui.RGBA32Image, rectIndex int) []byte {
+		rect := atlas.CustomRectByIndex(rectIndex)
+		require.True(t, rect.IsPacked())
+		pixels := (*[1 << 30]byte)(texture.Pixels)[: texture.Width*texture.Height*4 : texture.Width*texture.Height*4]
+		offset := (rect.Y*texture.Width + rect.X) * 4
+		return pixels[offset : offset+4]
+	}
+	assert.Equal(t, []byte{0xFF, 0x00, 0x00, 0xFF}, pixelAt(builder.TextureData(atlas, nil), red))
+
+	builder.RequestRebuild(func(atlas imgui.FontAtlas) { atlas.AddFontDefault() })
+	require.True(t, builder.ApplyRequestedRebuild(atlas))
+	assert.NotEqual(t, []byte{0xFF, 0x00, 0x00, 0xFF}, pixelAt(atlas.TextureDataRGBA32(), red),
+		"Rebuild should clear the texture data")
+	assert.Equal(t, []byte{0xFF, 0x00, 0x00, 0xFF}, pixelAt(builder.TextureData(atlas, nil), red),
+		"Rasterizer should write again after the rebuild")
+}
+
+func TestFontTextureBuilderDefersRebuild(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+
+	atlas := imgui.CurrentIO().Fonts()
+	atlas.AddFontDefault()
+	var builder imgui.FontTextureBuilder
+	assert.False(t, builder.ApplyRequestedRebuild(atlas), "Nothing should be rebuilt without request")
+
+	var calls []string
+	builder.RequestRebuild(func(atlas imgui.FontAtlas) {
+		calls = append(calls, "first")
+		atlas.AddFontDefault()
+	})
+	builder.RequestRebuild(nil)
+	builder.RequestRebuild(func(imgui.FontAtlas) { calls = append(calls, "second") })
+	assert.True(t, builder.RebuildRequested())
+	assert.Empty(t, calls, "Updates should be deferred")
+
+	require.True(t, builder.ApplyRequestedRebuild(atlas))
+	assert.Equal(t, []string{"first", "second"}, calls, "Updates should be called in order")
+	assert.False(t, builder.RebuildRequested())
+	assert.Equal(t, 2, atlas.FontCount())
+	assert.False(t, atlas.IsBuilt(), "Texture data should be cleared for the upload to build the atlas")
+	builder.TextureData(atlas, nil)
+	assert.True(t, atlas.IsBuilt())
+
+	assert.False(t, builder.ApplyRequestedRebuild(atlas), "Rebuild should be applied only once")
+	assert.Equal(t, []string{"first", "second"}, calls)
+}
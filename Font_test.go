@@ -0,0 +1,54 @@
+package imgui_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ianling/imgui-go"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFontMetrics(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+	imgui.CurrentIO().Fonts().TextureDataAlpha8()
+
+	font := imgui.DefaultFont
+	assert.Equal(t, float32(13), font.FontSize())
+	assert.True(t, font.Ascent() > 0, "Ascent should be positive")
+	assert.True(t, font.Descent() < 0, "Descent should be negative")
+	assert.Equal(t, '?', font.FallbackChar())
+
+	glyph := font.FindGlyph('A')
+	assert.Equal(t, 'A', glyph.Codepoint)
+	assert.True(t, glyph.Visible)
+	assert.Equal(t, font.CharAdvance('A'), glyph.AdvanceX)
+	assert.True(t, glyph.Max.X > glyph.Min.X, "Glyph should have a width")
+	assert.True(t, glyph.UV1.X > glyph.UV0.X, "Glyph should have texture coordinates")
+
+	_, found := font.FindGlyphNoFallback(0x1F600)
+	assert.False(t, found, "Default font has no emoji")
+	assert.Equal(t, font.FallbackChar(), font.FindGlyph(0x1F600).Codepoint)
+}
+
+func TestFontCalcTextSizeA(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+	imgui.CurrentIO().Fonts().TextureDataAlpha8()
+
+	font := imgui.DefaultFont
+	size := font.FontSize()
+	text := "hello world"
+	full, length := font.CalcTextSizeA(size, math.MaxFloat32, 0, text)
+	assert.Equal(t, len(text), length)
+	assert.Equal(t, size, full.Y)
+
+	limited, limitedLength := font.CalcTextSizeA(size, full.X/2, 0, text)
+	assert.True(t, limitedLength > 0 && limitedLength < len(text), "Measuring should stop at max width, got %d", limitedLength)
+	assert.True(t, limited.X <= full.X/2)
+
+	wrapAt := font.CalcWordWrapPositionA(1, text, full.X*0.75)
+	assert.Equal(t, len("hello"), wrapAt)
+	assert.Equal(t, len(text), font.CalcWordWrapPositionA(1, text, full.X*2))
+}
@@ -13,8 +13,14 @@ import "C"
 // - When disabled, FreeType generates blurrier glyphs, more or less matches the stb_truetype.h
 // - The Default hinting mode usually looks good, but may distort glyphs in an unusual way.
 // - The Light hinting mode generates fuzzier glyphs but better matches Microsoft's rasterizer.
-// You can set those flags globaly in FontAtlas.SetFontBuilderFlags(flags)
-// You can set those flags on a per font basis in FontConfig.SetFontBuilderFlags(flags).
+// You can set those flags globaly in FontAtlas.SetFreeTypeBuilderFlags(flags)
+// You can set those flags on a per font basis in FontConfig.SetFreeTypeBuilderFlags(flags).
+//
+// Color glyphs, such as those of emoji fonts, require FreeTypeBuilderFlagsLoadColor. They are kept in the texture
+// data only if the renderer uploads TextureDataRGBA32(), and are drawn without being tinted by the text color.
+type FreeTypeBuilderFlags uint
+
+// This is a list of FreeTypeBuilderFlags combinations.
 const (
 	// FreeTypeBuilderFlagsNoHinting disables hinting.
 	// This generally generates 'blurrier' bitmap glyphs when the glyph are rendered in any of the anti-aliased modes.
@@ -42,3 +48,25 @@ const (
 	// FreeTypeBuilderFlagsBitmap enables FreeType bitmap glyphs
 	FreeTypeBuilderFlagsBitmap = 1 << 9
 )
+
+// FreeTypeBuilderFlags returns the shared FreeType builder flags for all fonts.
+func (atlas FontAtlas) FreeTypeBuilderFlags() FreeTypeBuilderFlags {
+	return FreeTypeBuilderFlags(atlas.FontBuilderFlags())
+}
+
+// SetFreeTypeBuilderFlags sets the shared FreeType builder flags for all fonts.
+// These are combined with the flags of the individual font configurations.
+// The atlas must be built again for the flags to take effect.
+func (atlas FontAtlas) SetFreeTypeBuilderFlags(flags FreeTypeBuilderFlags) {
+	atlas.SetFontBuilderFlags(uint(flags))
+}
+
+// FreeTypeBuilderFlags returns the FreeType builder flags of the font.
+func (config FontConfig) FreeTypeBuilderFlags() FreeTypeBuilderFlags {
+	return FreeTypeBuilderFlags(config.FontBuilderFlags())
+}
+
+// SetFreeTypeBuilderFlags sets the FreeType builder flags of the font.
+func (config FontConfig) SetFreeTypeBuilderFlags(flags FreeTypeBuilderFlags) {
+	config.SetFontBuilderFlags(uint(flags))
+}
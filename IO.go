@@ -223,7 +223,28 @@ func (io IO) KeySuper(leftSuper int, rightSuper int) {
 	C.iggIoKeySuper(io.handle, C.int(leftSuper), C.int(rightSuper))
 }
 
+// KeyCtrlPressed returns true if the keyboard modifier control is pressed.
+func (io IO) KeyCtrlPressed() bool {
+	return C.iggIoKeyCtrlPressed(io.handle) != 0
+}
+
+// KeyShiftPressed returns true if the keyboard modifier shift is pressed.
+func (io IO) KeyShiftPressed() bool {
+	return C.iggIoKeyShiftPressed(io.handle) != 0
+}
+
+// KeyAltPressed returns true if the keyboard modifier alt is pressed.
+func (io IO) KeyAltPressed() bool {
+	return C.iggIoKeyAltPressed(io.handle) != 0
+}
+
+// KeySuperPressed returns true if the keyboard modifier super is pressed.
+func (io IO) KeySuperPressed() bool {
+	return C.iggIoKeySuperPressed(io.handle) != 0
+}
+
 // AddInputCharacters adds a new character into InputCharacters[].
+// Characters above UnicodeCodepointMax are replaced with U+FFFD.
 func (io IO) AddInputCharacters(chars string) {
 	textArg, textFin := wrapString(chars)
 	defer textFin()
@@ -385,3 +406,15 @@ func iggIoSetClipboardText(handle C.IggIO, text *C.char) {
 	}
 	board.SetText(C.GoString(text))
 }
+
+// SetClipboardText sets the text of the clipboard, as registered with IO.SetClipboard().
+func SetClipboardText(text string) {
+	textArg, textFin := wrapString(text)
+	defer textFin()
+	C.iggSetClipboardText(textArg)
+}
+
+// ClipboardText returns the current text of the clipboard, as registered with IO.SetClipboard().
+func ClipboardText() string {
+	return C.GoString(C.iggGetClipboardText())
+}
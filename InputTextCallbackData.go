@@ -22,6 +22,7 @@ var inputTextStates = make(map[C.int]*inputTextState)
 var inputTextStatesMutex sync.Mutex
 
 func newInputTextState(text string, cb InputTextCallback) *inputTextState {
+	observeSubmittedText(text)
 	state := &inputTextState{}
 	state.buf = newStringBuffer(text)
 	state.callback = cb
@@ -100,9 +101,10 @@ func (data InputTextCallbackData) EventChar() rune {
 // Returning 1 from the callback also drops the current input.
 // Only valid during CharFilter callback.
 //
-// Note: The internal representation of characters is based on uint16, so less than rune would provide.
+// Note: The internal representation of characters is based on uint16, so less than rune would provide,
+// unless built with 32-bit characters. See UnicodeCodepointMax.
 func (data InputTextCallbackData) SetEventChar(value rune) {
-	C.iggInputTextCallbackDataSetEventChar(data.handle, C.ushort(value))
+	C.iggInputTextCallbackDataSetEventChar(data.handle, C.uint(value))
 }
 
 // EventKey returns the currently pressed key. Valid for completion and history callbacks.
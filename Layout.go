@@ -20,6 +20,16 @@ func PopID() {
 	C.iggPopID()
 }
 
+// ID is the unique identifier of a widget, calculated as hash of the ID stack.
+type ID uint32
+
+// GetID returns the unique identifier of the given string within the current ID stack.
+func GetID(id string) ID {
+	idArg, idFin := wrapString(id)
+	defer idFin()
+	return ID(C.iggGetID(idArg))
+}
+
 // Separator is generally horizontal. Inside a menu bar or in horizontal layout mode, this becomes a vertical separator.
 func Separator() {
 	C.iggSeparator()
@@ -35,6 +45,11 @@ func SameLine() {
 	SameLineV(0, -1)
 }
 
+// NewLine undoes a SameLine() or forces a new line when in a horizontal layout context.
+func NewLine() {
+	C.iggNewLine()
+}
+
 // Spacing adds vertical spacing.
 func Spacing() {
 	C.iggSpacing()
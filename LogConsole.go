@@ -0,0 +1,448 @@
+package imgui
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// LogLevel is the severity of a line in a LogConsole.
+type LogLevel int
+
+// This is a list of LogLevel values.
+const (
+	LogLevelDebug LogLevel = iota
+	LogLevelInfo
+	LogLevelWarning
+	LogLevelError
+)
+
+// Color returns the color lines of the level are displayed with.
+// Info lines are displayed with the text color of the style instead.
+func (level LogLevel) Color() Vec4 {
+	switch level {
+	case LogLevelDebug:
+		return Vec4{X: 0.55, Y: 0.55, Z: 0.55, W: 1}
+	case LogLevelWarning:
+		return Vec4{X: 0.95, Y: 0.70, Z: 0.20, W: 1}
+	case LogLevelError:
+		return Vec4{X: 0.90, Y: 0.30, Z: 0.30, W: 1}
+	default:
+		return Vec4{X: 0.90, Y: 0.90, Z: 0.90, W: 1}
+	}
+}
+
+// String returns the name of the level.
+func (level LogLevel) String() string {
+	switch level {
+	case LogLevelDebug:
+		return "Debug"
+	case LogLevelWarning:
+		return "Warning"
+	case LogLevelError:
+		return "Error"
+	default:
+		return "Info"
+	}
+}
+
+// DetectLogLevel determines the level of a line by the words it contains, ignoring case:
+// "error", "fatal" and "panic" for errors, "warn" for warnings, and "debug" and "trace" for debug output.
+// All other lines are info.
+func DetectLogLevel(line string) LogLevel {
+	upper := strings.ToUpper(line)
+	switch {
+	case strings.Contains(upper, "ERROR") || strings.Contains(upper, "FATAL") || strings.Contains(upper, "PANIC"):
+		return LogLevelError
+	case strings.Contains(upper, "WARN"):
+		return LogLevelWarning
+	case strings.Contains(upper, "DEBUG") || strings.Contains(upper, "TRACE"):
+		return LogLevelDebug
+	default:
+		return LogLevelInfo
+	}
+}
+
+// LogLine is a line of a LogConsole.
+type LogLine struct {
+	Level LogLevel
+	Text  string
+}
+
+// LogConsole is a widget to display a large log, with an optional command input line.
+//
+// The lines are kept in a ring buffer of fixed capacity, dropping the oldest lines once it is full. Only the
+// visible lines are drawn, so the console can hold millions of lines. The console implements io.Writer to be used
+// with a log.Logger, and can be written to from any goroutine. Lines are added once their newline is written.
+//
+// The displayed lines can be filtered with the syntax of TextFilter, and copied to the clipboard.
+// While AutoScroll is enabled, the console follows new lines, unless the user has scrolled up.
+//
+// Usage:
+//   console := imgui.NewLogConsole(100000)
+//   console.Execute = func(command string) { ... }
+//   logger := log.New(console, "", log.LstdFlags)
+//   errors := log.New(console.LevelWriter(imgui.LogLevelError), "", log.LstdFlags)
+//   ...
+//   imgui.Begin("Console")
+//   console.Render()
+//   imgui.End()
+type LogConsole struct {
+	// Execute is called with each command entered in the input line. Without function, the input line is hidden.
+	Execute func(command string)
+	// EchoCommands writes each entered command as "> command" line before it is executed.
+	EchoCommands bool
+	// DetectLevel determines the level of lines written with Write(). Without function, all lines are info.
+	DetectLevel func(line string) LogLevel
+	// AutoScroll keeps the newest line visible while the view is scrolled to the bottom.
+	AutoScroll bool
+	// HistoryLimit is the maximum number of remembered commands. Zero means unlimited.
+	HistoryLimit int
+
+	mutex    sync.Mutex
+	capacity int
+	lines    []LogLine
+	head     int
+	count    int
+	total    uint64
+	partial  []byte
+
+	filter   string
+	matcher  *TextMatcher
+	filtered []uint64
+	scanned  uint64
+
+	command        string
+	history        []string
+	historyPos     int
+	scrollToBottom bool
+}
+
+// NewLogConsole returns an empty console that keeps up to capacity lines.
+func NewLogConsole(capacity int) *LogConsole {
+	if capacity < 1 {
+		capacity = 1
+	}
+	initial := capacity
+	if initial > 1024 {
+		initial = 1024
+	}
+	return &LogConsole{
+		EchoCommands: true,
+		DetectLevel:  DetectLogLevel,
+		AutoScroll:   true,
+		HistoryLimit: 100,
+		capacity:     capacity,
+		lines:        make([]LogLine, 0, initial),
+		historyPos:   -1,
+	}
+}
+
+// add appends a line, dropping the oldest one if the buffer is full. The mutex must be locked.
+func (console *LogConsole) add(level LogLevel, text string) {
+	line := LogLine{Level: level, Text: strings.TrimSuffix(text, "\r")}
+	if len(console.lines) < console.capacity {
+		console.lines = append(console.lines, line)
+		console.count++
+	} else {
+		console.lines[console.head] = line
+		console.head = (console.head + 1) % console.capacity
+	}
+	console.total++
+}
+
+// line returns the line with given sequence number, counting all lines ever added. The mutex must be locked.
+func (console *LogConsole) line(sequence uint64) LogLine {
+	offset := int(sequence - (console.total - uint64(console.count)))
+	return console.lines[(console.head+offset)%len(console.lines)]
+}
+
+// Write adds the complete lines of p. An incomplete last line is kept until its newline is written.
+// The level of the lines is determined by DetectLevel.
+func (console *LogConsole) Write(p []byte) (int, error) {
+	console.mutex.Lock()
+	defer console.mutex.Unlock()
+	console.partial = console.writeLines(console.partial, p, func(line string) LogLevel {
+		if console.DetectLevel == nil {
+			return LogLevelInfo
+		}
+		return console.DetectLevel(line)
+	})
+	return len(p), nil
+}
+
+// writeLines adds the complete lines of partial and p, returning the remaining incomplete line.
+func (console *LogConsole) writeLines(partial []byte, p []byte, level func(line string) LogLevel) []byte {
+	partial = append(partial, p...)
+	for {
+		end := bytes.IndexByte(partial, '\n')
+		if end < 0 {
+			return partial
+		}
+		line := string(partial[:end])
+		console.add(level(line), line)
+		partial = partial[end+1:]
+	}
+}
+
+type logConsoleLevelWriter struct {
+	console *LogConsole
+	level   LogLevel
+	partial []byte
+}
+
+func (writer *logConsoleLevelWriter) Write(p []byte) (int, error) {
+	console := writer.console
+	console.mutex.Lock()
+	defer console.mutex.Unlock()
+	writer.partial = console.writeLines(writer.partial, p, func(string) LogLevel { return writer.level })
+	return len(p), nil
+}
+
+// LevelWriter returns a writer that adds its lines with given level, regardless of DetectLevel.
+func (console *LogConsole) LevelWriter(level LogLevel) io.Writer {
+	return &logConsoleLevelWriter{console: console, level: level}
+}
+
+// Log adds the lines of text with given level.
+func (console *LogConsole) Log(level LogLevel, text string) {
+	console.mutex.Lock()
+	defer console.mutex.Unlock()
+	for _, line := range strings.Split(strings.TrimSuffix(text, "\n"), "\n") {
+		console.add(level, line)
+	}
+}
+
+// Logf adds the formatted text with given level.
+func (console *LogConsole) Logf(level LogLevel, format string, args ...interface{}) {
+	console.Log(level, fmt.Sprintf(format, args...))
+}
+
+// Clear removes all lines.
+func (console *LogConsole) Clear() {
+	console.mutex.Lock()
+	defer console.mutex.Unlock()
+	console.clear()
+}
+
+func (console *LogConsole) clear() {
+	console.lines = console.lines[:0]
+	console.head = 0
+	console.count = 0
+	console.filtered = nil
+	console.scanned = console.total
+}
+
+// Len returns the number of lines in the console.
+func (console *LogConsole) Len() int {
+	console.mutex.Lock()
+	defer console.mutex.Unlock()
+	return console.count
+}
+
+// Lines returns a copy of all lines in the console, oldest first.
+func (console *LogConsole) Lines() []LogLine {
+	console.mutex.Lock()
+	defer console.mutex.Unlock()
+	lines := make([]LogLine, console.count)
+	for index := range lines {
+		lines[index] = console.lines[(console.head+index)%len(console.lines)]
+	}
+	return lines
+}
+
+// SetFilter sets the filter of the displayed lines, with the syntax of TextFilter, such as "error,-timeout".
+func (console *LogConsole) SetFilter(filter string) {
+	console.mutex.Lock()
+	defer console.mutex.Unlock()
+	console.setFilter(filter)
+}
+
+func (console *LogConsole) setFilter(filter string) {
+	console.filter = filter
+	console.matcher, _ = NewTextMatcher(filter, TextMatchModeSubstring)
+	console.filtered = nil
+	console.scanned = console.total - uint64(console.count)
+}
+
+// Filter returns the filter of the displayed lines.
+func (console *LogConsole) Filter() string {
+	console.mutex.Lock()
+	defer console.mutex.Unlock()
+	return console.filter
+}
+
+// updateFiltered matches the lines added since the last update against the filter, and forgets dropped lines.
+// Returns false if no filter is active. The mutex must be locked.
+func (console *LogConsole) updateFiltered() bool {
+	if (console.matcher == nil) || !console.matcher.IsActive() {
+		return false
+	}
+	oldest := console.total - uint64(console.count)
+	dropped := sort.Search(len(console.filtered), func(index int) bool { return console.filtered[index] >= oldest })
+	console.filtered = console.filtered[dropped:]
+	if console.scanned < oldest {
+		console.scanned = oldest
+	}
+	for ; console.scanned < console.total; console.scanned++ {
+		if console.matcher.Pass(console.line(console.scanned).Text) {
+			console.filtered = append(console.filtered, console.scanned)
+		}
+	}
+	return true
+}
+
+// visibleLines returns the number of lines passing the filter, and a function to get them by index.
+// The mutex must be locked.
+func (console *LogConsole) visibleLines() (int, func(index int) LogLine) {
+	if console.updateFiltered() {
+		return len(console.filtered), func(index int) LogLine { return console.line(console.filtered[index]) }
+	}
+	oldest := console.total - uint64(console.count)
+	return console.count, func(index int) LogLine { return console.line(oldest + uint64(index)) }
+}
+
+// Text returns the lines that pass the filter, separated by newlines. This is what the "Copy" button copies.
+func (console *LogConsole) Text() string {
+	console.mutex.Lock()
+	defer console.mutex.Unlock()
+	return console.text()
+}
+
+func (console *LogConsole) text() string {
+	count, line := console.visibleLines()
+	var builder strings.Builder
+	for index := 0; index < count; index++ {
+		builder.WriteString(line(index).Text)
+		builder.WriteByte('\n')
+	}
+	return builder.String()
+}
+
+// History returns the entered commands, oldest first.
+func (console *LogConsole) History() []string {
+	console.mutex.Lock()
+	defer console.mutex.Unlock()
+	return append([]string(nil), console.history...)
+}
+
+// Render displays the console within the current window, filling the available space.
+func (console *LogConsole) Render() {
+	command, entered := console.render()
+	if !entered {
+		return
+	}
+	if console.EchoCommands {
+		console.Log(LogLevelInfo, "> "+command)
+	}
+	if console.Execute != nil {
+		console.Execute(command)
+	}
+}
+
+func (console *LogConsole) render() (string, bool) {
+	console.mutex.Lock()
+	defer console.mutex.Unlock()
+
+	filter := console.filter
+	SetNextItemWidth(CalcItemWidth() / 2)
+	if InputTextWithHint("##filter", "Filter (inc,-exc)", &filter) {
+		console.setFilter(filter)
+	}
+	SameLine()
+	Checkbox("Auto-scroll", &console.AutoScroll)
+	SameLine()
+	if Button("Copy") {
+		SetClipboardText(console.text())
+	}
+	SameLine()
+	if Button("Clear") {
+		console.clear()
+	}
+	Separator()
+
+	footerHeight := float32(0)
+	if console.Execute != nil {
+		footerHeight = CurrentStyle().ItemSpacing().Y + FrameHeightWithSpacing()
+	}
+	BeginChildV("##lines", Vec2{Y: -footerHeight}, false, WindowFlagsHorizontalScrollbar)
+	count, line := console.visibleLines()
+	var clipper ListClipper
+	clipper.Begin(count)
+	for clipper.Step() {
+		for index := clipper.DisplayStart; index < clipper.DisplayEnd; index++ {
+			entry := line(index)
+			if entry.Level != LogLevelInfo {
+				PushStyleColor(StyleColorText, entry.Level.Color())
+			}
+			Text(entry.Text)
+			if entry.Level != LogLevelInfo {
+				PopStyleColor()
+			}
+		}
+	}
+	if console.scrollToBottom || (console.AutoScroll && (ScrollY() >= ScrollMaxY())) {
+		SetScrollHereY(1)
+	}
+	console.scrollToBottom = false
+	EndChild()
+
+	if console.Execute == nil {
+		return "", false
+	}
+	Separator()
+	SetNextItemWidth(-1)
+	flags := InputTextFlagsEnterReturnsTrue | InputTextFlagsCallbackHistory
+	entered := InputTextV("##command", &console.command, flags, console.recallHistory)
+	if !entered {
+		return "", false
+	}
+	SetKeyboardFocusHereV(-1)
+	command := strings.TrimSpace(console.command)
+	console.command = ""
+	console.historyPos = -1
+	if command == "" {
+		return "", false
+	}
+	if count := len(console.history); (count == 0) || (console.history[count-1] != command) {
+		console.history = append(console.history, command)
+	}
+	if (console.HistoryLimit > 0) && (len(console.history) > console.HistoryLimit) {
+		console.history = console.history[len(console.history)-console.HistoryLimit:]
+	}
+	console.scrollToBottom = true
+	return command, true
+}
+
+// recallHistory replaces the command input with the previous or next command of the history.
+func (console *LogConsole) recallHistory(data InputTextCallbackData) int32 {
+	previous := console.historyPos
+	switch data.EventKey() {
+	case KeyUpArrow:
+		if console.historyPos == -1 {
+			console.historyPos = len(console.history) - 1
+		} else if console.historyPos > 0 {
+			console.historyPos--
+		}
+	case KeyDownArrow:
+		if console.historyPos != -1 {
+			console.historyPos++
+			if console.historyPos >= len(console.history) {
+				console.historyPos = -1
+			}
+		}
+	}
+	if previous == console.historyPos {
+		return 0
+	}
+	text := ""
+	if console.historyPos >= 0 {
+		text = console.history[console.historyPos]
+	}
+	data.DeleteBytes(0, len(data.Buffer()))
+	data.InsertBytes(0, []byte(text))
+	return 0
+}
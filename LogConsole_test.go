@@ -0,0 +1,112 @@
+package imgui_test
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/ianling/imgui-go"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogConsoleRingBuffer(t *testing.T) {
+	console := imgui.NewLogConsole(3)
+	logger := log.New(console, "", 0)
+	logger.Println("starting")
+	logger.Println("WARN: disk almost full")
+	logger.Printf("connection error: %s", "timeout")
+	logger.Println("done")
+
+	assert.Equal(t, []imgui.LogLine{
+		{Level: imgui.LogLevelWarning, Text: "WARN: disk almost full"},
+		{Level: imgui.LogLevelError, Text: "connection error: timeout"},
+		{Level: imgui.LogLevelInfo, Text: "done"},
+	}, console.Lines(), "Oldest line should be dropped")
+
+	_, err := fmt.Fprint(console, "partial")
+	require.Nil(t, err)
+	assert.Equal(t, "done", console.Lines()[2].Text, "Incomplete line should not be added")
+	_, _ = fmt.Fprint(console, " line\r\nnext\n")
+	assert.Equal(t, []string{"partial line", "next"},
+		[]string{console.Lines()[1].Text, console.Lines()[2].Text})
+
+	debug := log.New(console.LevelWriter(imgui.LogLevelDebug), "", 0)
+	debug.Println("error is expected here")
+	assert.Equal(t, imgui.LogLevelDebug, console.Lines()[2].Level, "Level writer should not detect levels")
+
+	console.SetFilter("line,next")
+	assert.Equal(t, "partial line\nnext\n", console.Text())
+	console.Clear()
+	assert.Equal(t, 0, console.Len())
+	assert.Equal(t, "", console.Text())
+}
+
+type logConsoleTestClipboard struct {
+	text string
+}
+
+func (board *logConsoleTestClipboard) Text() (string, error) {
+	return board.text, nil
+}
+
+func (board *logConsoleTestClipboard) SetText(value string) {
+	board.text = value
+}
+
+func TestLogConsoleRender(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 600, Y: 400})
+	defer context.Destroy()
+	io := imgui.CurrentIO()
+	const keyEnter, keyUp = 1, 2
+	io.KeyMap(imgui.KeyEnter, keyEnter)
+	io.KeyMap(imgui.KeyUpArrow, keyUp)
+	clipboard := &logConsoleTestClipboard{}
+	io.SetClipboard(clipboard)
+
+	console := imgui.NewLogConsole(100000)
+	var executed []string
+	console.Execute = func(command string) {
+		executed = append(executed, command)
+		console.Logf(imgui.LogLevelInfo, "ran %s", command)
+	}
+	for index := 0; index < 100000; index++ {
+		console.Logf(imgui.LogLevelInfo, "line %d", index)
+	}
+	render := func() { renderTestWindow(console.Render) }
+	pressKey := func(key int) {
+		io.KeyPress(key)
+		render()
+		io.KeyRelease(key)
+		render()
+	}
+	render()
+
+	// The command input line is at the bottom of the window.
+	io.SetMousePosition(imgui.Vec2{X: 300, Y: 380})
+	for _, down := range []bool{true, false} {
+		io.SetMouseButtonDown(0, down)
+		render()
+	}
+	io.AddInputCharacters("help")
+	render()
+	pressKey(keyEnter)
+	pressKey(keyUp)
+	pressKey(keyEnter)
+	assert.Equal(t, []string{"help", "help"}, executed, "History should recall the last command")
+	assert.Equal(t, []string{"help"}, console.History())
+	lines := console.Lines()
+	assert.Equal(t, "> help", lines[len(lines)-2].Text)
+	assert.Equal(t, "ran help", lines[len(lines)-1].Text)
+
+	// The copy button is in the toolbar at the top, after the filter and the auto-scroll checkbox.
+	console.SetFilter("ran")
+	io.SetMousePosition(imgui.Vec2{X: 335, Y: 17})
+	for _, down := range []bool{true, false} {
+		io.SetMouseButtonDown(0, down)
+		render()
+	}
+	assert.Equal(t, "ran help\nran help\n", clipboard.text, "Copy should copy the filtered lines")
+	assert.Equal(t, clipboard.text, imgui.ClipboardText())
+}
@@ -13,9 +13,18 @@ func Time() float64 {
 	return float64(C.iggGetTime())
 }
 
+// FrameCount returns the imgui frame count. Incremented by 1 every frame.
+func FrameCount() int {
+	return int(C.iggGetFrameCount())
+}
+
 // NewFrame starts a new ImGui frame, you can submit any command from this point until Render()/EndFrame().
+// Files dragged over or dropped onto the application from outside (see BeginExternFilesDrag() and DropExternFiles())
+// are submitted as drag and drop source.
 func NewFrame() {
+	holdExternDragMouseButton()
 	C.iggNewFrame()
+	submitExternDrop()
 }
 
 // Render ends the ImGui frame, finalize the draw data.
@@ -0,0 +1,703 @@
+package imgui
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MarkdownBlockKind identifies the type of a MarkdownBlock.
+type MarkdownBlockKind int
+
+const (
+	// MarkdownBlockParagraph is a paragraph of Spans.
+	MarkdownBlockParagraph MarkdownBlockKind = iota
+	// MarkdownBlockHeading is a heading of Level 1 to 6, with Spans.
+	MarkdownBlockHeading
+	// MarkdownBlockList is a list with items as Children. Ordered lists start counting at Start.
+	MarkdownBlockList
+	// MarkdownBlockListItem is an item of a list, with its content as Children.
+	MarkdownBlockListItem
+	// MarkdownBlockCode is a code block with Text, and an optional Language.
+	MarkdownBlockCode
+	// MarkdownBlockQuote is a block quote with its content as Children.
+	MarkdownBlockQuote
+	// MarkdownBlockTable is a table with Header, Rows and Alignments.
+	MarkdownBlockTable
+	// MarkdownBlockRule is a horizontal rule.
+	MarkdownBlockRule
+)
+
+// MarkdownStyle is a combination of inline styles of a MarkdownSpan.
+type MarkdownStyle int
+
+const (
+	// MarkdownStyleNone is plain text.
+	MarkdownStyleNone MarkdownStyle = 0
+	// MarkdownStyleEmphasis is text within single asterisks or underscores, usually rendered in italics.
+	MarkdownStyleEmphasis MarkdownStyle = 1 << 0
+	// MarkdownStyleStrong is text within double asterisks or underscores, usually rendered in bold.
+	MarkdownStyleStrong MarkdownStyle = 1 << 1
+	// MarkdownStyleStrikethrough is text within double tildes.
+	MarkdownStyleStrikethrough MarkdownStyle = 1 << 2
+	// MarkdownStyleCode is text within backticks.
+	MarkdownStyleCode MarkdownStyle = 1 << 3
+)
+
+// MarkdownAlignment is the alignment of a table column.
+type MarkdownAlignment int
+
+const (
+	// MarkdownAlignmentNone uses the default alignment, to the left.
+	MarkdownAlignmentNone MarkdownAlignment = iota
+	// MarkdownAlignmentLeft aligns to the left, marked as ":---".
+	MarkdownAlignmentLeft
+	// MarkdownAlignmentCenter centers the text, marked as ":---:".
+	MarkdownAlignmentCenter
+	// MarkdownAlignmentRight aligns to the right, marked as "---:".
+	MarkdownAlignmentRight
+)
+
+// MarkdownSpan is a piece of inline text with a single style.
+type MarkdownSpan struct {
+	// Text of the span. Hard line breaks are kept as "\n". For images, it is the alternative text.
+	Text  string
+	Style MarkdownStyle
+	// Link is the destination of a link, or empty.
+	Link string
+	// Image is the source of an image, or empty. An image may also have a Link.
+	Image string
+}
+
+// MarkdownBlock is a block element of a MarkdownDocument. Which fields are used depends on the Kind.
+type MarkdownBlock struct {
+	Kind MarkdownBlockKind
+	// Level is the level of a heading, from 1 to 6.
+	Level int
+	// Spans are the inline content of paragraphs and headings.
+	Spans []MarkdownSpan
+	// Children are the blocks of lists, list items and quotes.
+	Children []MarkdownBlock
+
+	// Ordered is set for numbered lists, which start counting at Start.
+	Ordered bool
+	Start   int
+
+	// Text is the content of a code block, and Language the optional info of its fence.
+	Text     string
+	Language string
+
+	// Header, Rows and Alignments describe a table. Each cell is a list of spans.
+	Header     [][]MarkdownSpan
+	Rows       [][][]MarkdownSpan
+	Alignments []MarkdownAlignment
+}
+
+// MarkdownDocument is the parsed form of a Markdown text.
+// It supports the common subset of CommonMark and GitHub Flavored Markdown: headings, emphasis,
+// lists, code blocks, block quotes, tables, rules, links and images. Embedded HTML is kept as text.
+type MarkdownDocument struct {
+	Blocks []MarkdownBlock
+}
+
+var (
+	markdownHeading     = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+	markdownRule        = regexp.MustCompile(`^ {0,3}(?:(?:-[ \t]*){3,}|(?:\*[ \t]*){3,}|(?:_[ \t]*){3,})$`)
+	markdownSetext      = regexp.MustCompile(`^ {0,3}(=+|-+)[ \t]*$`)
+	markdownFence       = regexp.MustCompile("^( {0,3})(`{3,}|~{3,})[ \t]*([^`]*)$")
+	markdownQuote       = regexp.MustCompile(`^ {0,3}>[ ]?(.*)$`)
+	markdownListItem    = regexp.MustCompile(`^( {0,3})([-+*]|[0-9]{1,9}[.)])(?:([ \t]+)(.*))?$`)
+	markdownTableDivide = regexp.MustCompile(`^[ \t]*\|?[ \t]*:?-+:?[ \t]*(?:\|[ \t]*:?-+:?[ \t]*)*\|?[ \t]*$`)
+)
+
+// ParseMarkdown parses the given Markdown text.
+// See MarkdownV() for a widget that renders documents, caching them by their content.
+func ParseMarkdown(text string) *MarkdownDocument {
+	text = strings.Replace(text, "\r\n", "\n", -1)
+	text = strings.Replace(text, "\t", "    ", -1)
+	return &MarkdownDocument{Blocks: parseMarkdownBlocks(strings.Split(text, "\n"))}
+}
+
+func isBlankLine(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+func lineIndent(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// trimIndent removes up to given amount of leading spaces.
+func trimIndent(line string, amount int) string {
+	indent := lineIndent(line)
+	if indent > amount {
+		indent = amount
+	}
+	return line[indent:]
+}
+
+// startsMarkdownBlock returns true if the line starts a block that interrupts a paragraph.
+func startsMarkdownBlock(line string) bool {
+	if markdownHeading.MatchString(line) || markdownRule.MatchString(line) ||
+		markdownFence.MatchString(line) || markdownQuote.MatchString(line) {
+		return true
+	}
+	// Only lists that are not empty, and ordered ones that start with 1, interrupt a paragraph.
+	marker, isItem := parseMarkdownListMarker(line)
+	return isItem && (marker.content != "") && (!marker.ordered || (marker.number == 1))
+}
+
+func parseMarkdownBlocks(lines []string) []MarkdownBlock {
+	var blocks []MarkdownBlock
+	for index := 0; index < len(lines); {
+		line := lines[index]
+		if isBlankLine(line) {
+			index++
+			continue
+		}
+		var block MarkdownBlock
+		var next int
+		switch {
+		case markdownFence.MatchString(line):
+			block, next = parseMarkdownFence(lines, index)
+		case markdownHeading.MatchString(line):
+			match := markdownHeading.FindStringSubmatch(line)
+			block = MarkdownBlock{Kind: MarkdownBlockHeading, Level: len(match[1]), Spans: parseMarkdownInline(match[2])}
+			next = index + 1
+		case markdownRule.MatchString(line):
+			block = MarkdownBlock{Kind: MarkdownBlockRule}
+			next = index + 1
+		case markdownQuote.MatchString(line):
+			block, next = parseMarkdownQuote(lines, index)
+		case markdownListItem.MatchString(line):
+			block, next = parseMarkdownList(lines, index)
+		case lineIndent(line) >= 4:
+			block, next = parseMarkdownIndentedCode(lines, index)
+		case isMarkdownTableStart(lines, index):
+			block, next = parseMarkdownTable(lines, index)
+		default:
+			block, next = parseMarkdownParagraph(lines, index)
+		}
+		blocks = append(blocks, block)
+		index = next
+	}
+	return blocks
+}
+
+func parseMarkdownFence(lines []string, start int) (MarkdownBlock, int) {
+	match := markdownFence.FindStringSubmatch(lines[start])
+	indent := len(match[1])
+	fence := match[2]
+	block := MarkdownBlock{Kind: MarkdownBlockCode, Language: strings.TrimSpace(match[3])}
+	var content []string
+	index := start + 1
+	for ; index < len(lines); index++ {
+		trimmed := strings.TrimSpace(lines[index])
+		if (lineIndent(lines[index]) < 4) && strings.HasPrefix(trimmed, fence) && (strings.Trim(trimmed, fence[:1]) == "") {
+			index++
+			break
+		}
+		content = append(content, trimIndent(lines[index], indent))
+	}
+	block.Text = strings.Join(content, "\n")
+	return block, index
+}
+
+func parseMarkdownIndentedCode(lines []string, start int) (MarkdownBlock, int) {
+	var content []string
+	index := start
+	for ; (index < len(lines)) && (isBlankLine(lines[index]) || (lineIndent(lines[index]) >= 4)); index++ {
+		content = append(content, trimIndent(lines[index], 4))
+	}
+	for (len(content) > 0) && isBlankLine(content[len(content)-1]) {
+		content = content[:len(content)-1]
+	}
+	return MarkdownBlock{Kind: MarkdownBlockCode, Text: strings.Join(content, "\n")}, start + len(content)
+}
+
+func parseMarkdownQuote(lines []string, start int) (MarkdownBlock, int) {
+	var content []string
+	index := start
+	for ; index < len(lines); index++ {
+		line := lines[index]
+		if match := markdownQuote.FindStringSubmatch(line); match != nil {
+			content = append(content, match[1])
+			continue
+		}
+		// Lazy continuation of a paragraph within the quote.
+		lastLine := content[len(content)-1]
+		if isBlankLine(line) || isBlankLine(lastLine) || startsMarkdownBlock(line) {
+			break
+		}
+		content = append(content, line)
+	}
+	return MarkdownBlock{Kind: MarkdownBlockQuote, Children: parseMarkdownBlocks(content)}, index
+}
+
+// markdownListMarker describes the marker of a list item.
+type markdownListMarker struct {
+	ordered   bool
+	delimiter byte
+	number    int
+	indent    int
+	// contentIndent is the column at which the content of the item starts.
+	contentIndent int
+	content       string
+}
+
+func parseMarkdownListMarker(line string) (markdownListMarker, bool) {
+	match := markdownListItem.FindStringSubmatch(line)
+	if match == nil {
+		return markdownListMarker{}, false
+	}
+	marker := markdownListMarker{indent: len(match[1]), content: match[4]}
+	symbol := match[2]
+	marker.delimiter = symbol[len(symbol)-1]
+	if (marker.delimiter == '.') || (marker.delimiter == ')') {
+		marker.ordered = true
+		marker.number, _ = strconv.Atoi(symbol[:len(symbol)-1])
+	}
+	spacing := len(match[3])
+	switch {
+	case strings.TrimSpace(match[4]) == "":
+		spacing = 1
+		marker.content = ""
+	case spacing > 4:
+		// Content that starts with an indented code block is indented by a single space.
+		spacing = 1
+		marker.content = match[3][1:] + match[4]
+	}
+	marker.contentIndent = marker.indent + len(symbol) + spacing
+	return marker, true
+}
+
+func (marker markdownListMarker) continues(other markdownListMarker) bool {
+	return (marker.ordered == other.ordered) && (marker.delimiter == other.delimiter)
+}
+
+func parseMarkdownList(lines []string, start int) (MarkdownBlock, int) {
+	first, _ := parseMarkdownListMarker(lines[start])
+	block := MarkdownBlock{Kind: MarkdownBlockList, Ordered: first.ordered, Start: first.number}
+	index := start
+	for index < len(lines) {
+		marker, isItem := parseMarkdownListMarker(lines[index])
+		if !isItem || !marker.continues(first) {
+			break
+		}
+		content := []string{marker.content}
+		index++
+		for ; index < len(lines); index++ {
+			line := lines[index]
+			if isBlankLine(line) {
+				content = append(content, "")
+				continue
+			}
+			if lineIndent(line) >= marker.contentIndent {
+				content = append(content, line[marker.contentIndent:])
+				continue
+			}
+			previousBlank := isBlankLine(content[len(content)-1])
+			if previousBlank || startsMarkdownBlock(line) || markdownListItem.MatchString(line) {
+				break
+			}
+			// Lazy continuation of a paragraph within the item.
+			content = append(content, strings.TrimLeft(line, " "))
+		}
+		block.Children = append(block.Children, MarkdownBlock{
+			Kind:     MarkdownBlockListItem,
+			Children: parseMarkdownBlocks(content),
+		})
+	}
+	return block, index
+}
+
+func splitMarkdownTableRow(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, "\\|") {
+		line = line[:len(line)-1]
+	}
+	var cells []string
+	var cell strings.Builder
+	inCode := false
+	for index := 0; index < len(line); index++ {
+		c := line[index]
+		switch {
+		case (c == '\\') && (index+1 < len(line)) && (line[index+1] == '|'):
+			cell.WriteByte('|')
+			index++
+		case c == '`':
+			inCode = !inCode
+			cell.WriteByte(c)
+		case (c == '|') && !inCode:
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(c)
+		}
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+func isMarkdownTableStart(lines []string, index int) bool {
+	if (index+1 >= len(lines)) || !strings.Contains(lines[index], "|") || !markdownTableDivide.MatchString(lines[index+1]) {
+		return false
+	}
+	return len(splitMarkdownTableRow(lines[index])) == len(splitMarkdownTableRow(lines[index+1]))
+}
+
+func parseMarkdownTable(lines []string, start int) (MarkdownBlock, int) {
+	block := MarkdownBlock{Kind: MarkdownBlockTable}
+	for _, divider := range splitMarkdownTableRow(lines[start+1]) {
+		alignment := MarkdownAlignmentNone
+		left := strings.HasPrefix(divider, ":")
+		right := strings.HasSuffix(divider, ":")
+		switch {
+		case left && right:
+			alignment = MarkdownAlignmentCenter
+		case left:
+			alignment = MarkdownAlignmentLeft
+		case right:
+			alignment = MarkdownAlignmentRight
+		}
+		block.Alignments = append(block.Alignments, alignment)
+	}
+	parseRow := func(line string) [][]MarkdownSpan {
+		cells := splitMarkdownTableRow(line)
+		row := make([][]MarkdownSpan, len(block.Alignments))
+		for column := 0; (column < len(cells)) && (column < len(row)); column++ {
+			row[column] = parseMarkdownInline(cells[column])
+		}
+		return row
+	}
+	block.Header = parseRow(lines[start])
+	index := start + 2
+	for ; (index < len(lines)) && !isBlankLine(lines[index]) && !startsMarkdownBlock(lines[index]); index++ {
+		block.Rows = append(block.Rows, parseRow(lines[index]))
+	}
+	return block, index
+}
+
+func parseMarkdownParagraph(lines []string, start int) (MarkdownBlock, int) {
+	content := []string{lines[start]}
+	index := start + 1
+	for ; index < len(lines); index++ {
+		line := lines[index]
+		if match := markdownSetext.FindStringSubmatch(line); match != nil {
+			level := 1
+			if match[1][0] == '-' {
+				level = 2
+			}
+			return MarkdownBlock{
+				Kind:  MarkdownBlockHeading,
+				Level: level,
+				Spans: parseMarkdownInline(joinMarkdownLines(content)),
+			}, index + 1
+		}
+		if isBlankLine(line) || startsMarkdownBlock(line) || isMarkdownTableStart(lines, index) {
+			break
+		}
+		content = append(content, line)
+	}
+	return MarkdownBlock{Kind: MarkdownBlockParagraph, Spans: parseMarkdownInline(joinMarkdownLines(content))}, index
+}
+
+// joinMarkdownLines joins the lines of a paragraph with spaces, keeping hard line breaks.
+// A hard line break is a line that ends with two spaces, or a backslash.
+func joinMarkdownLines(lines []string) string {
+	var builder strings.Builder
+	for index, line := range lines {
+		line = strings.TrimLeft(line, " ")
+		last := index == len(lines)-1
+		switch {
+		case last:
+			builder.WriteString(strings.TrimRight(line, " "))
+		case strings.HasSuffix(line, "  "):
+			builder.WriteString(strings.TrimRight(line, " "))
+			builder.WriteString("\n")
+		case strings.HasSuffix(line, "\\") && !strings.HasSuffix(line, "\\\\"):
+			builder.WriteString(line[:len(line)-1])
+			builder.WriteString("\n")
+		default:
+			builder.WriteString(strings.TrimRight(line, " "))
+			builder.WriteString(" ")
+		}
+	}
+	return builder.String()
+}
+
+// markdownInlineParser splits inline text into spans of the same style.
+type markdownInlineParser struct {
+	spans []MarkdownSpan
+	text  strings.Builder
+	style MarkdownStyle
+	link  string
+}
+
+func parseMarkdownInline(text string) []MarkdownSpan {
+	var parser markdownInlineParser
+	parser.parse(text)
+	parser.flush()
+	return parser.spans
+}
+
+func (parser *markdownInlineParser) flush() {
+	if parser.text.Len() == 0 {
+		return
+	}
+	parser.spans = append(parser.spans, MarkdownSpan{Text: parser.text.String(), Style: parser.style, Link: parser.link})
+	parser.text.Reset()
+}
+
+func (parser *markdownInlineParser) toggle(style MarkdownStyle) {
+	parser.flush()
+	parser.style ^= style
+}
+
+func isMarkdownPunctuation(c byte) bool {
+	return (c < utf8.RuneSelf) && (unicode.IsPunct(rune(c)) || unicode.IsSymbol(rune(c)))
+}
+
+func isMarkdownWordCharacter(text string, index int) bool {
+	if (index < 0) || (index >= len(text)) {
+		return false
+	}
+	c := text[index]
+	return (c >= utf8.RuneSelf) || unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c))
+}
+
+func (parser *markdownInlineParser) parse(text string) {
+	for index := 0; index < len(text); {
+		c := text[index]
+		rest := text[index:]
+		switch {
+		case (c == '\\') && (index+1 < len(text)) && isMarkdownPunctuation(text[index+1]):
+			parser.text.WriteByte(text[index+1])
+			index += 2
+		case c == '`':
+			index += parser.parseCode(rest)
+		case (c == '!') && strings.HasPrefix(rest, "!["):
+			if length := parser.parseLink(rest[1:], true); length > 0 {
+				index += 1 + length
+			} else {
+				parser.text.WriteByte(c)
+				index++
+			}
+		case (c == '[') && (parser.link == ""):
+			if length := parser.parseLink(rest, false); length > 0 {
+				index += length
+			} else {
+				parser.text.WriteByte(c)
+				index++
+			}
+		case (c == '<') && (parser.link == ""):
+			index += parser.parseAutolink(rest)
+		case (c == '*') || (c == '_') || (c == '~'):
+			index += parser.parseDelimiter(text, index)
+		default:
+			parser.text.WriteByte(c)
+			index++
+		}
+	}
+}
+
+// parseCode handles a code span that starts with a run of backticks, returning the length of consumed text.
+func (parser *markdownInlineParser) parseCode(text string) int {
+	run := len(text) - len(strings.TrimLeft(text, "`"))
+	fence := text[:run]
+	for search := run; search < len(text); {
+		end := strings.Index(text[search:], fence)
+		if end < 0 {
+			break
+		}
+		end += search
+		closingRun := len(text[end:]) - len(strings.TrimLeft(text[end:], "`"))
+		if closingRun != run {
+			search = end + closingRun
+			continue
+		}
+		code := strings.Replace(text[run:end], "\n", " ", -1)
+		if (len(code) > 2) && (code[0] == ' ') && (code[len(code)-1] == ' ') && (strings.TrimSpace(code) != "") {
+			code = code[1 : len(code)-1]
+		}
+		parser.flush()
+		parser.spans = append(parser.spans, MarkdownSpan{Text: code, Style: parser.style | MarkdownStyleCode, Link: parser.link})
+		return end + run
+	}
+	parser.text.WriteString(fence)
+	return run
+}
+
+// markdownLink describes the extent of a link or image in inline text, which starts with "[".
+type markdownLink struct {
+	label       string
+	destination string
+	length      int
+}
+
+func findMarkdownLink(text string) (markdownLink, bool) {
+	depth := 0
+	labelEnd := -1
+	for index := 0; (index < len(text)) && (labelEnd < 0); index++ {
+		switch text[index] {
+		case '\\':
+			index++
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				labelEnd = index
+			}
+		}
+	}
+	if (labelEnd < 0) || (labelEnd+1 >= len(text)) || (text[labelEnd+1] != '(') {
+		return markdownLink{}, false
+	}
+	destinationEnd := strings.IndexByte(text[labelEnd:], ')')
+	if destinationEnd < 0 {
+		return markdownLink{}, false
+	}
+	destinationEnd += labelEnd
+	destination := strings.TrimSpace(text[labelEnd+2 : destinationEnd])
+	// An optional title, separated by a space, is ignored.
+	if space := strings.IndexAny(destination, " \t"); space >= 0 {
+		destination = destination[:space]
+	}
+	return markdownLink{
+		label:       text[1:labelEnd],
+		destination: strings.TrimSuffix(strings.TrimPrefix(destination, "<"), ">"),
+		length:      destinationEnd + 1,
+	}, true
+}
+
+// plainMarkdownText returns the text of inline Markdown without any styles.
+func plainMarkdownText(text string) string {
+	var plain strings.Builder
+	for _, span := range parseMarkdownInline(text) {
+		plain.WriteString(span.Text)
+	}
+	return plain.String()
+}
+
+// parseLink handles a link or image that starts with "[", returning the length of consumed text,
+// or zero if the text is no link.
+func (parser *markdownInlineParser) parseLink(text string, image bool) int {
+	link, isLink := findMarkdownLink(text)
+	if !isLink {
+		return 0
+	}
+	parser.flush()
+	if image {
+		parser.spans = append(parser.spans,
+			MarkdownSpan{Text: plainMarkdownText(link.label), Style: parser.style, Link: parser.link, Image: link.destination})
+		return link.length
+	}
+	if strings.HasPrefix(link.label, "![") {
+		// A linked image, which is clickable as a whole.
+		if inner, isImage := findMarkdownLink(link.label[1:]); isImage && (inner.length == len(link.label)-1) {
+			parser.spans = append(parser.spans, MarkdownSpan{
+				Text:  plainMarkdownText(inner.label),
+				Style: parser.style,
+				Link:  link.destination,
+				Image: inner.destination,
+			})
+			return link.length
+		}
+	}
+	outerStyle := parser.style
+	parser.link = link.destination
+	parser.parse(link.label)
+	parser.flush()
+	parser.link = ""
+	parser.style = outerStyle
+	return link.length
+}
+
+// parseAutolink handles a link within angle brackets, such as <https://example.com>.
+func (parser *markdownInlineParser) parseAutolink(text string) int {
+	end := strings.IndexByte(text, '>')
+	if end > 0 {
+		destination := text[1:end]
+		if !strings.ContainsAny(destination, " <") && (strings.Contains(destination, "://") || strings.Contains(destination, "@")) {
+			parser.flush()
+			parser.spans = append(parser.spans, MarkdownSpan{Text: destination, Style: parser.style, Link: destination})
+			return end + 1
+		}
+	}
+	parser.text.WriteByte('<')
+	return 1
+}
+
+// parseDelimiter handles a run of emphasis or strikethrough delimiters at given index,
+// returning the length of consumed text. A delimiter only opens a style if a matching one follows.
+func (parser *markdownInlineParser) parseDelimiter(text string, index int) int {
+	c := text[index]
+	run := 1
+	for (index+run < len(text)) && (text[index+run] == c) {
+		run++
+	}
+	delimiter := text[index : index+run]
+	if c == '~' {
+		if run != 2 {
+			parser.text.WriteString(delimiter)
+			return run
+		}
+		parser.applyDelimiter(text, index, delimiter, MarkdownStyleStrikethrough)
+		return run
+	}
+	// Intra-word underscores, as in snake_case, are no delimiters.
+	if (c == '_') && isMarkdownWordCharacter(text, index-1) && isMarkdownWordCharacter(text, index+run) {
+		parser.text.WriteString(delimiter)
+		return run
+	}
+	switch run {
+	case 1:
+		parser.applyDelimiter(text, index, delimiter, MarkdownStyleEmphasis)
+	case 2:
+		parser.applyDelimiter(text, index, delimiter, MarkdownStyleStrong)
+	case 3:
+		parser.applyDelimiter(text, index, delimiter, MarkdownStyleStrong|MarkdownStyleEmphasis)
+	default:
+		parser.text.WriteString(delimiter)
+	}
+	return run
+}
+
+func (parser *markdownInlineParser) applyDelimiter(text string, index int, delimiter string, style MarkdownStyle) {
+	end := index + len(delimiter)
+	closing := (parser.style & style) == style
+	switch {
+	case closing && (index > 0) && (text[index-1] != ' '):
+		parser.toggle(style)
+	case !closing && (parser.style&style == 0) && (end < len(text)) && (text[end] != ' ') &&
+		hasMarkdownClosingDelimiter(text[end:], delimiter):
+		parser.toggle(style)
+	default:
+		parser.text.WriteString(delimiter)
+	}
+}
+
+// hasMarkdownClosingDelimiter returns true if the text contains the delimiter as a run of its own,
+// following a character that is no space.
+func hasMarkdownClosingDelimiter(text string, delimiter string) bool {
+	for search := 0; search < len(text); {
+		found := strings.Index(text[search:], delimiter)
+		if found < 0 {
+			return false
+		}
+		found += search
+		after := found + len(delimiter)
+		before := found - 1
+		if ((before < 0) || (text[before] != delimiter[0])) && ((after >= len(text)) || (text[after] != delimiter[0])) &&
+			(before >= 0) && (text[before] != ' ') {
+			return true
+		}
+		search = after
+		for (search < len(text)) && (text[search] == delimiter[0]) {
+			search++
+		}
+	}
+	return false
+}
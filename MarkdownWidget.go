@@ -0,0 +1,429 @@
+package imgui
+
+import (
+	"crypto/sha256"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// MarkdownConfig describes how MarkdownV() renders a document.
+// Fonts that are DefaultFont keep the current font; styles without a font of their own are rendered
+// in the font of the surrounding text.
+type MarkdownConfig struct {
+	// HeadingFonts are the fonts of headings, from level 1 to 6. Headings of level 1 and 2 are underlined.
+	HeadingFonts [6]Font
+	// BoldFont is used for strong text, ItalicFont for emphasized text, and BoldItalicFont for both.
+	BoldFont       Font
+	ItalicFont     Font
+	BoldItalicFont Font
+	// CodeFont is used for code spans and code blocks, preferably a monospaced font.
+	CodeFont Font
+
+	// LinkColor is the color of links. A zero value uses the color of StyleColorButtonHovered.
+	LinkColor Vec4
+	// LinkCallback is called with the destination of a link the user clicked on.
+	// If nil, links can not be clicked, and the destination is only shown as a tooltip.
+	LinkCallback func(link string)
+	// ImageCallback resolves the source of an image to a texture, and its size in pixels.
+	// Images wider than the available space are scaled down. If nil, or if the image is not available,
+	// the alternative text is shown instead.
+	ImageCallback func(source string) (id TextureID, size Vec2, ok bool)
+}
+
+// markdownCacheLimit is the number of parsed documents kept before the cache is started anew.
+const markdownCacheLimit = 64
+
+// markdownTableMaxColumns is the number of columns imgui tables support. Further columns are not shown.
+const markdownTableMaxColumns = 64
+
+var markdownCache = struct {
+	mutex     sync.Mutex
+	documents map[[sha256.Size]byte]*MarkdownDocument
+}{}
+
+// cachedMarkdownDocument returns the parsed document of the text, by the hash of its content.
+func cachedMarkdownDocument(text string) *MarkdownDocument {
+	key := sha256.Sum256([]byte(text))
+	markdownCache.mutex.Lock()
+	defer markdownCache.mutex.Unlock()
+	if document, cached := markdownCache.documents[key]; cached {
+		return document
+	}
+	document := ParseMarkdown(text)
+	if (markdownCache.documents == nil) || (len(markdownCache.documents) >= markdownCacheLimit) {
+		markdownCache.documents = make(map[[sha256.Size]byte]*MarkdownDocument)
+	}
+	markdownCache.documents[key] = document
+	return document
+}
+
+// Markdown calls MarkdownV(text, MarkdownConfig{}).
+func Markdown(text string) {
+	MarkdownV(text, MarkdownConfig{})
+}
+
+// MarkdownV renders the given Markdown text with the current font, wrapping text at the end of the
+// content region. Parsed documents are cached by the hash of their text, so it can be called every frame.
+// Tables show at most their first 64 columns, the maximum of imgui.
+//
+// Usage:
+//   config := imgui.MarkdownConfig{HeadingFonts: [6]imgui.Font{h1, h2, h3}, LinkCallback: openBrowser}
+//   ...
+//   imgui.MarkdownV(releaseNotes, config)
+func MarkdownV(text string, config MarkdownConfig) {
+	cachedMarkdownDocument(text).Render(config)
+}
+
+// Render submits the document with given config.
+func (document *MarkdownDocument) Render(config MarkdownConfig) {
+	renderer := markdownRenderer{config: &config, font: CurrentFont()}
+	PushTextWrapPos()
+	renderer.renderBlocks(document.Blocks)
+	PopTextWrapPos()
+}
+
+type markdownRenderer struct {
+	config *MarkdownConfig
+	// font is the font of the current block.
+	font Font
+}
+
+func (renderer *markdownRenderer) pushFont(font Font) {
+	if font == DefaultFont {
+		font = renderer.font
+	}
+	PushFont(font)
+}
+
+func (renderer *markdownRenderer) spanFont(style MarkdownStyle) Font {
+	var font Font
+	switch {
+	case style&MarkdownStyleCode != 0:
+		font = renderer.config.CodeFont
+	case style&(MarkdownStyleStrong|MarkdownStyleEmphasis) == MarkdownStyleStrong|MarkdownStyleEmphasis:
+		font = renderer.config.BoldItalicFont
+	case style&MarkdownStyleStrong != 0:
+		font = renderer.config.BoldFont
+	case style&MarkdownStyleEmphasis != 0:
+		font = renderer.config.ItalicFont
+	}
+	return font
+}
+
+func (renderer *markdownRenderer) linkColor() Vec4 {
+	if renderer.config.LinkColor != (Vec4{}) {
+		return renderer.config.LinkColor
+	}
+	return CurrentStyle().Color(StyleColorButtonHovered)
+}
+
+func markdownStyleColor(id StyleColorID) PackedColor {
+	return PackedColorFromVec4(CurrentStyle().Color(id))
+}
+
+func (renderer *markdownRenderer) renderBlocks(blocks []MarkdownBlock) {
+	for index, block := range blocks {
+		if index > 0 {
+			Spacing()
+		}
+		PushIDInt(index)
+		renderer.renderBlock(block)
+		PopID()
+	}
+}
+
+func (renderer *markdownRenderer) renderBlock(block MarkdownBlock) {
+	switch block.Kind {
+	case MarkdownBlockParagraph:
+		renderer.renderSpans(block.Spans)
+	case MarkdownBlockHeading:
+		renderer.renderHeading(block)
+	case MarkdownBlockList:
+		renderer.renderList(block)
+	case MarkdownBlockListItem:
+		renderer.renderBlocks(block.Children)
+	case MarkdownBlockCode:
+		renderer.renderCode(block.Text)
+	case MarkdownBlockQuote:
+		renderer.renderQuote(block)
+	case MarkdownBlockTable:
+		renderer.renderTable(block)
+	case MarkdownBlockRule:
+		Separator()
+	}
+}
+
+func (renderer *markdownRenderer) renderHeading(block MarkdownBlock) {
+	outerFont := renderer.font
+	if font := renderer.config.HeadingFonts[block.Level-1]; font != DefaultFont {
+		renderer.font = font
+	}
+	PushFont(renderer.font)
+	renderer.renderSpans(block.Spans)
+	PopFont()
+	renderer.font = outerFont
+	if block.Level <= 2 {
+		Separator()
+	}
+}
+
+func (renderer *markdownRenderer) renderList(block MarkdownBlock) {
+	var markerWidth float32
+	if block.Ordered {
+		markerWidth = CalcTextSize(strconv.Itoa(block.Start+len(block.Children)-1)+". ", false, -1).X
+	} else {
+		markerWidth = FontSize()
+	}
+	color := markdownStyleColor(StyleColorText)
+	for index, item := range block.Children {
+		markerPos := CursorScreenPos()
+		lineHeight := TextLineHeight()
+		IndentV(markerWidth)
+		PushIDInt(index)
+		if len(item.Children) > 0 {
+			renderer.renderBlocks(item.Children)
+		} else {
+			Dummy(Vec2{X: 0, Y: lineHeight})
+		}
+		PopID()
+		UnindentV(markerWidth)
+
+		if block.Ordered {
+			WindowDrawList().AddText(markerPos, color, strconv.Itoa(block.Start+index)+".")
+		} else {
+			center := Vec2{X: markerPos.X + markerWidth*0.5, Y: markerPos.Y + lineHeight*0.5}
+			WindowDrawList().AddCircleFilled(center, FontSize()*0.18, color)
+		}
+	}
+}
+
+func (renderer *markdownRenderer) renderCode(text string) {
+	renderer.pushFont(renderer.config.CodeFont)
+	defer PopFont()
+	padding := CurrentStyle().FramePadding()
+	textSize := CalcTextSize(text, false, -1)
+	size := Vec2{X: ContentRegionAvail().X, Y: textSize.Y + padding.Y*2}
+	if size.X < textSize.X+padding.X*2 {
+		size.X = textSize.X + padding.X*2
+	}
+	pos := CursorScreenPos()
+	drawList := WindowDrawList()
+	drawList.AddRectFilled(pos, pos.Plus(size), markdownStyleColor(StyleColorFrameBg))
+	drawList.AddText(pos.Plus(padding), markdownStyleColor(StyleColorText), text)
+	Dummy(size)
+}
+
+func (renderer *markdownRenderer) renderQuote(block MarkdownBlock) {
+	barWidth := FontSize() * 0.25
+	indent := FontSize()
+	left := CursorScreenPos().X
+	IndentV(indent)
+	BeginGroup()
+	renderer.renderBlocks(block.Children)
+	EndGroup()
+	UnindentV(indent)
+	min, max := ItemRectMin(), ItemRectMax()
+	WindowDrawList().AddRectFilled(Vec2{X: left, Y: min.Y}, Vec2{X: left + barWidth, Y: max.Y},
+		markdownStyleColor(StyleColorSeparator))
+}
+
+func (renderer *markdownRenderer) renderTable(block MarkdownBlock) {
+	columns := len(block.Alignments)
+	if columns > markdownTableMaxColumns {
+		columns = markdownTableMaxColumns
+	}
+	if (columns == 0) || !BeginTableV("##table", columns, TableFlagsBorders|TableFlagsRowBg|TableFlagsSizingStretchProp, Vec2{}, 0) {
+		return
+	}
+	for column := 0; column < columns; column++ {
+		label := markdownSpansText(block.Header[column])
+		TableSetupColumnV(label+"##"+strconv.Itoa(column), TableColumnFlagsNone, 0, uint(column))
+	}
+	TableHeadersRow()
+	for row, cells := range block.Rows {
+		TableNextRow()
+		PushIDInt(row)
+		for column, spans := range cells {
+			if (column >= columns) || !TableSetColumnIndex(column) {
+				continue
+			}
+			PushIDInt(column)
+			renderer.alignCell(spans, block.Alignments[column])
+			renderer.renderSpans(spans)
+			PopID()
+		}
+		PopID()
+	}
+	EndTable()
+}
+
+// alignCell moves the cursor for the content of a cell, if it fits on a single line.
+func (renderer *markdownRenderer) alignCell(spans []MarkdownSpan, alignment MarkdownAlignment) {
+	if (alignment != MarkdownAlignmentCenter) && (alignment != MarkdownAlignmentRight) {
+		return
+	}
+	var width float32
+	for _, span := range spans {
+		renderer.pushFont(renderer.spanFont(span.Style))
+		width += CalcTextSize(span.Text, false, -1).X
+		PopFont()
+	}
+	space := ContentRegionAvail().X - width
+	if space <= 0 {
+		return
+	}
+	if alignment == MarkdownAlignmentCenter {
+		space *= 0.5
+	}
+	SetCursorPos(Vec2{X: CursorPosX() + space, Y: CursorPosY()})
+}
+
+func markdownSpansText(spans []MarkdownSpan) string {
+	var builder strings.Builder
+	for _, span := range spans {
+		builder.WriteString(span.Text)
+	}
+	return builder.String()
+}
+
+// markdownLine tracks the layout of inline items on the current line.
+type markdownLine struct {
+	// empty is true if no item was placed on the current line yet.
+	empty bool
+}
+
+// place positions the cursor for an inline item of given width, after the previous one if it fits on the line.
+// It returns the space available on the line.
+func (line *markdownLine) place(width float32) float32 {
+	if line.empty {
+		line.empty = false
+		return ContentRegionAvail().X
+	}
+	SameLineV(0, 0)
+	available := ContentRegionAvail().X
+	if width > available {
+		NewLine()
+		available = ContentRegionAvail().X
+	}
+	return available
+}
+
+// renderSpans lays out the spans word by word, wrapping at the end of the content region.
+func (renderer *markdownRenderer) renderSpans(spans []MarkdownSpan) {
+	line := markdownLine{empty: true}
+	for index, span := range spans {
+		PushIDInt(index)
+		if span.Image != "" {
+			renderer.renderImage(&line, span)
+		} else {
+			renderer.renderTextSpan(&line, span)
+		}
+		PopID()
+	}
+	if line.empty {
+		// Keep the height of empty paragraphs and table cells.
+		Dummy(Vec2{X: 0, Y: TextLineHeight()})
+	}
+}
+
+func (renderer *markdownRenderer) renderTextSpan(line *markdownLine, span MarkdownSpan) {
+	renderer.pushFont(renderer.spanFont(span.Style))
+	defer PopFont()
+	if span.Link != "" {
+		PushStyleColor(StyleColorText, renderer.linkColor())
+		defer PopStyleColor()
+	}
+	var rects [][2]Vec2
+	for lineIndex, text := range strings.Split(span.Text, "\n") {
+		if lineIndex > 0 {
+			if line.empty {
+				Dummy(Vec2{X: 0, Y: TextLineHeight()})
+			}
+			line.empty = true
+		}
+		for _, word := range splitMarkdownWords(text) {
+			size := CalcTextSize(strings.TrimRight(word, " "), false, -1)
+			line.place(size.X)
+			if span.Style&MarkdownStyleCode != 0 {
+				pos := CursorScreenPos()
+				WindowDrawList().AddRectFilled(pos, pos.Plus(CalcTextSize(word, false, -1)),
+					markdownStyleColor(StyleColorFrameBg))
+			}
+			Text(word)
+			rects = append(rects, [2]Vec2{ItemRectMin(), ItemRectMax()})
+			if span.Style&MarkdownStyleStrikethrough != 0 {
+				min, max := ItemRectMin(), ItemRectMax()
+				middle := (min.Y + max.Y) * 0.5
+				WindowDrawList().AddLine(Vec2{X: min.X, Y: middle}, Vec2{X: max.X, Y: middle},
+					markdownStyleColor(StyleColorText))
+			}
+		}
+	}
+	if span.Link != "" {
+		renderer.handleLink(span.Link, rects)
+	}
+}
+
+// handleLink underlines the words of a link while one of them is hovered, and reports clicks.
+func (renderer *markdownRenderer) handleLink(link string, rects [][2]Vec2) {
+	hovered := false
+	mouse := MousePos()
+	for _, rect := range rects {
+		if (mouse.X >= rect[0].X) && (mouse.X < rect[1].X) && (mouse.Y >= rect[0].Y) && (mouse.Y < rect[1].Y) {
+			hovered = true
+		}
+	}
+	if !hovered || !IsWindowHovered() {
+		return
+	}
+	color := PackedColorFromVec4(renderer.linkColor())
+	for _, rect := range rects {
+		WindowDrawList().AddLine(Vec2{X: rect[0].X, Y: rect[1].Y - 1}, Vec2{X: rect[1].X, Y: rect[1].Y - 1}, color)
+	}
+	SetTooltip(link)
+	if renderer.config.LinkCallback != nil {
+		SetMouseCursor(MouseCursorHand)
+		if IsMouseClicked(0) {
+			renderer.config.LinkCallback(link)
+		}
+	}
+}
+
+func (renderer *markdownRenderer) renderImage(line *markdownLine, span MarkdownSpan) {
+	var id TextureID
+	var size Vec2
+	available := false
+	if renderer.config.ImageCallback != nil {
+		id, size, available = renderer.config.ImageCallback(span.Image)
+	}
+	if !available || (size.X <= 0) || (size.Y <= 0) {
+		renderer.renderTextSpan(line, MarkdownSpan{Text: span.Text, Style: span.Style | MarkdownStyleEmphasis, Link: span.Link})
+		return
+	}
+	if space := line.place(size.X); size.X > space {
+		size = size.Times(space / size.X)
+	}
+	Image(id, size)
+	if span.Link != "" {
+		renderer.handleLink(span.Link, [][2]Vec2{{ItemRectMin(), ItemRectMax()}})
+	} else if (span.Text != "") && IsItemHovered() {
+		SetTooltip(span.Text)
+	}
+}
+
+// splitMarkdownWords splits text into words, each with its trailing spaces.
+func splitMarkdownWords(text string) []string {
+	var words []string
+	start := 0
+	for index := 0; index < len(text); index++ {
+		if (text[index] == ' ') && ((index+1 == len(text)) || (text[index+1] != ' ')) {
+			words = append(words, text[start:index+1])
+			start = index + 1
+		}
+	}
+	if start < len(text) {
+		words = append(words, text[start:])
+	}
+	return words
+}
@@ -0,0 +1,137 @@
+package imgui_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ianling/imgui-go"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMarkdownBlocks(t *testing.T) {
+	document := imgui.ParseMarkdown("# Title\n\nSome text\ncontinued.\n\n---\n\n" +
+		"```go\nfmt.Println()\n```\n\n> quoted\n> text\n\n    indented code\n\nSub\n===\n")
+	kinds := make([]imgui.MarkdownBlockKind, len(document.Blocks))
+	for index, block := range document.Blocks {
+		kinds[index] = block.Kind
+	}
+	require.Equal(t, []imgui.MarkdownBlockKind{
+		imgui.MarkdownBlockHeading, imgui.MarkdownBlockParagraph, imgui.MarkdownBlockRule,
+		imgui.MarkdownBlockCode, imgui.MarkdownBlockQuote, imgui.MarkdownBlockCode, imgui.MarkdownBlockHeading,
+	}, kinds)
+
+	assert.Equal(t, 1, document.Blocks[0].Level)
+	assert.Equal(t, []imgui.MarkdownSpan{{Text: "Title"}}, document.Blocks[0].Spans)
+	assert.Equal(t, []imgui.MarkdownSpan{{Text: "Some text continued."}}, document.Blocks[1].Spans)
+	assert.Equal(t, "go", document.Blocks[3].Language)
+	assert.Equal(t, "fmt.Println()", document.Blocks[3].Text)
+	if assert.Len(t, document.Blocks[4].Children, 1) {
+		assert.Equal(t, []imgui.MarkdownSpan{{Text: "quoted text"}}, document.Blocks[4].Children[0].Spans)
+	}
+	assert.Equal(t, "indented code", document.Blocks[5].Text)
+	assert.Equal(t, 1, document.Blocks[6].Level)
+}
+
+func TestParseMarkdownLists(t *testing.T) {
+	document := imgui.ParseMarkdown("- one\n- two\n  - nested\n\n3. three\n4. four\n")
+	require.Len(t, document.Blocks, 2)
+
+	bullets := document.Blocks[0]
+	assert.False(t, bullets.Ordered)
+	require.Len(t, bullets.Children, 2)
+	assert.Equal(t, []imgui.MarkdownSpan{{Text: "one"}}, bullets.Children[0].Children[0].Spans)
+	second := bullets.Children[1].Children
+	require.Len(t, second, 2)
+	assert.Equal(t, imgui.MarkdownBlockList, second[1].Kind)
+	assert.Equal(t, []imgui.MarkdownSpan{{Text: "nested"}}, second[1].Children[0].Children[0].Spans)
+
+	numbers := document.Blocks[1]
+	assert.True(t, numbers.Ordered)
+	assert.Equal(t, 3, numbers.Start)
+	assert.Len(t, numbers.Children, 2)
+}
+
+func TestParseMarkdownInline(t *testing.T) {
+	tt := []struct {
+		text     string
+		expected []imgui.MarkdownSpan
+	}{
+		{text: "a *b* c", expected: []imgui.MarkdownSpan{
+			{Text: "a "}, {Text: "b", Style: imgui.MarkdownStyleEmphasis}, {Text: " c"}}},
+		{text: "**bold** and __also__", expected: []imgui.MarkdownSpan{
+			{Text: "bold", Style: imgui.MarkdownStyleStrong}, {Text: " and "}, {Text: "also", Style: imgui.MarkdownStyleStrong}}},
+		{text: "~~gone~~", expected: []imgui.MarkdownSpan{{Text: "gone", Style: imgui.MarkdownStyleStrikethrough}}},
+		{text: "use `a*b` here", expected: []imgui.MarkdownSpan{
+			{Text: "use "}, {Text: "a*b", Style: imgui.MarkdownStyleCode}, {Text: " here"}}},
+		{text: "2 * 3 * 4", expected: []imgui.MarkdownSpan{{Text: "2 * 3 * 4"}}},
+		{text: "snake_case_name", expected: []imgui.MarkdownSpan{{Text: "snake_case_name"}}},
+		{text: `\*literal\*`, expected: []imgui.MarkdownSpan{{Text: "*literal*"}}},
+		{text: "see [the **docs**](https://example.com \"Title\")", expected: []imgui.MarkdownSpan{
+			{Text: "see "},
+			{Text: "the ", Link: "https://example.com"},
+			{Text: "docs", Style: imgui.MarkdownStyleStrong, Link: "https://example.com"}}},
+		{text: "![logo](logo.png)", expected: []imgui.MarkdownSpan{{Text: "logo", Image: "logo.png"}}},
+		{text: "[![logo](logo.png)](home)", expected: []imgui.MarkdownSpan{{Text: "logo", Image: "logo.png", Link: "home"}}},
+		{text: "<https://example.com>", expected: []imgui.MarkdownSpan{{Text: "https://example.com", Link: "https://example.com"}}},
+		{text: "line  \nbreak", expected: []imgui.MarkdownSpan{{Text: "line\nbreak"}}},
+	}
+	for _, tc := range tt {
+		td := tc
+		t.Run(td.text, func(t *testing.T) {
+			document := imgui.ParseMarkdown(td.text)
+			require.Len(t, document.Blocks, 1)
+			assert.Equal(t, td.expected, document.Blocks[0].Spans)
+		})
+	}
+}
+
+func TestParseMarkdownTable(t *testing.T) {
+	document := imgui.ParseMarkdown("| Name | Size |\n|:-----|-----:|\n| `a` | 1 |\n| b \\| c | 22 |\n")
+	require.Len(t, document.Blocks, 1)
+	table := document.Blocks[0]
+	assert.Equal(t, imgui.MarkdownBlockTable, table.Kind)
+	assert.Equal(t, []imgui.MarkdownAlignment{imgui.MarkdownAlignmentLeft, imgui.MarkdownAlignmentRight}, table.Alignments)
+	assert.Equal(t, [][]imgui.MarkdownSpan{{{Text: "Name"}}, {{Text: "Size"}}}, table.Header)
+	require.Len(t, table.Rows, 2)
+	assert.Equal(t, []imgui.MarkdownSpan{{Text: "a", Style: imgui.MarkdownStyleCode}}, table.Rows[0][0])
+	assert.Equal(t, []imgui.MarkdownSpan{{Text: "b | c"}}, table.Rows[1][0])
+}
+
+func TestMarkdownRendersTablesWithTooManyColumns(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 400, Y: 600})
+	defer context.Destroy()
+
+	row := "|" + strings.Repeat(" x |", 70) + "\n"
+	text := row + "|" + strings.Repeat("---|", 70) + "\n" + row
+	assert.NotPanics(t, func() {
+		renderTestWindow(func() { imgui.Markdown(text) })
+	}, "Columns beyond the maximum of imgui should be skipped")
+}
+
+func TestMarkdownRendersLinksAndImages(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 400, Y: 600})
+	defer context.Destroy()
+	io := imgui.CurrentIO()
+
+	var clicked []string
+	var images []string
+	config := imgui.MarkdownConfig{
+		LinkCallback: func(link string) { clicked = append(clicked, link) },
+		ImageCallback: func(source string) (imgui.TextureID, imgui.Vec2, bool) {
+			images = append(images, source)
+			return imgui.TextureID(1), imgui.Vec2{X: 2000, Y: 100}, true
+		},
+	}
+	text := "[Link](target) text\n\n# Heading\n\n- item\n\n> quote\n\n```\ncode\n```\n\n" +
+		"| a | b |\n|---|:-:|\n| 1 | 2 |\n\n![image](image.png) ~~old~~ `code`\n"
+	io.SetMousePosition(imgui.Vec2{X: 12, Y: 12})
+	for frame := 0; frame < 3; frame++ {
+		io.SetMouseButtonDown(0, frame == 2)
+		renderTestWindow(func() { imgui.MarkdownV(text, config) })
+	}
+
+	assert.Equal(t, []string{"target"}, clicked)
+	assert.Equal(t, []string{"image.png", "image.png", "image.png"}, images)
+}
@@ -43,6 +43,8 @@ type GLFW struct {
 	tps              int
 	time             float64
 	mouseJustPressed [3]bool
+	dropPos          Vec2
+	dropFrames       int
 
 	mouseCursors map[MouseCursorID]*glfw.Cursor
 
@@ -260,7 +262,11 @@ func (platform *GLFW) NewFrame() {
 	platform.time = currentTime
 
 	// Setup inputs
-	if platform.window.GetAttrib(glfw.Focused) != 0 {
+	if platform.dropFrames > 0 {
+		// Keep the mouse where the files were dropped until imgui delivered them.
+		platform.imguiIO.SetMousePosition(platform.dropPos)
+		platform.dropFrames--
+	} else if platform.window.GetAttrib(glfw.Focused) != 0 {
 		x, y := platform.window.GetCursorPos()
 		platform.imguiIO.SetMousePosition(Vec2{X: float32(x), Y: float32(y)})
 	} else {
@@ -301,6 +307,16 @@ func (platform *GLFW) SetInputCallback(cb func(key glfw.Key, mods glfw.ModifierK
 	platform.inputCallback = cb
 }
 
+// ShortcutKeys returns the names and GLFW keys of the function keys, to be added to ShortcutManager.Keys.
+// The letters, digits and punctuation keys are already known, as their GLFW keys match their ASCII codes.
+func (platform *GLFW) ShortcutKeys() map[string]int {
+	keys := make(map[string]int)
+	for number := 1; number <= 25; number++ {
+		keys[fmt.Sprintf("F%d", number)] = int(glfw.KeyF1) + number - 1
+	}
+	return keys
+}
+
 func (platform *GLFW) updateMouseCursor() {
 	io := platform.imguiIO
 	if (io.ConfigFlags() & ConfigFlagsNoMouseCursorChange) == 1 || platform.window.GetInputMode(glfw.CursorMode) == glfw.CursorDisabled {
@@ -367,8 +383,16 @@ var glfwButtonIDByIndex = map[int]glfw.MouseButton{
 	2: glfw.MouseButton3,
 }
 
+// onDrop offers the dropped files to drag and drop targets. GLFW has no events for files dragged over the window,
+// so BeginExternFilesDrag() can not be called, and targets are highlighted only during the drop.
 func (platform *GLFW) onDrop(window *glfw.Window, names []string) {
 	window.Focus()
+	platform.imguiIO.SetFrameCountSinceLastInput(0)
+
+	x, y := window.GetCursorPos()
+	platform.dropPos = Vec2{X: float32(x), Y: float32(y)}
+	platform.dropFrames = externDropFrames
+	DropExternFiles(names)
 
 	if platform.dropCallback != nil {
 		platform.dropCallback(names)
@@ -38,7 +38,7 @@ const (
 // Only call EndPopup() if BeginPopup() returns true.
 // WindowFlags are forwarded to the window.
 func BeginPopupV(name string, flags WindowFlags) bool {
-	nameArg, nameFin := wrapString(name)
+	nameArg, nameFin := wrapString(shapedLabel(name))
 	defer nameFin()
 	return C.iggBeginPopup(nameArg, C.int(flags)) != 0
 }
@@ -52,7 +52,7 @@ func BeginPopup(name string) bool {
 // can't close the modal window by clicking outside).
 // WindowFlags are forwarded to the window.
 func BeginPopupModalV(name string, open *bool, flags WindowFlags) bool {
-	nameArg, nameFin := wrapString(name)
+	nameArg, nameFin := wrapString(submittedLabel(name))
 	defer nameFin()
 	openArg, openFin := wrapBool(open)
 	defer openFin()
@@ -74,7 +74,7 @@ func EndPopup() {
 // By default, Selectable()/MenuItem() are calling CloseCurrentPopup().
 // Popup identifiers are relative to the current ID-stack (so OpenPopup and BeginPopup needs to be at the same level).
 func OpenPopupV(id string, flags PopupFlags) {
-	idArg, idFin := wrapString(id)
+	idArg, idFin := wrapString(shapedLabel(id))
 	defer idFin()
 	C.iggOpenPopup(idArg, C.int(flags))
 }
@@ -86,7 +86,7 @@ func OpenPopup(id string) {
 
 // OpenPopupOnItemClickV helper to open popup when clicked on last item. return true when just opened. (note: actually triggers on the mouse _released_ event to be consistent with popup behaviors).
 func OpenPopupOnItemClickV(id string, flags PopupFlags) {
-	idArg, idFin := wrapString(id)
+	idArg, idFin := wrapString(shapedLabel(id))
 	defer idFin()
 	C.iggOpenPopupOnItemClick(idArg, C.int(flags))
 }
@@ -105,7 +105,7 @@ func CloseCurrentPopup() {
 // BeginPopupContextItemV returns true if the identified mouse button was pressed
 // while hovering over the last item.
 func BeginPopupContextItemV(id string, flags PopupFlags) bool {
-	idArg, idFin := wrapString(id)
+	idArg, idFin := wrapString(shapedLabel(id))
 	defer idFin()
 	return C.iggBeginPopupContextItem(idArg, C.int(flags)) != 0
 }
@@ -117,7 +117,7 @@ func BeginPopupContextItem() bool {
 
 // BeginPopupContextWindowV open+begin popup when clicked on current window.
 func BeginPopupContextWindowV(id string, flags PopupFlags) bool {
-	idArg, idFin := wrapString(id)
+	idArg, idFin := wrapString(shapedLabel(id))
 	defer idFin()
 	return C.iggBeginPopupContextWindow(idArg, C.int(flags)) != 0
 }
@@ -129,7 +129,7 @@ func BeginPopupContextWindow() bool {
 
 // BeginPopupContextVoidV open+begin popup when clicked in void (where there are no windows).
 func BeginPopupContextVoidV(id string, flags PopupFlags) bool {
-	idArg, idFin := wrapString(id)
+	idArg, idFin := wrapString(shapedLabel(id))
 	defer idFin()
 	return C.iggBeginPopupContextVoid(idArg, C.int(flags)) != 0
 }
@@ -144,7 +144,7 @@ func BeginPopupContextVoid() bool {
 // IsPopupOpenV(id, PopupFlagsAnyPopupID: return true if any popup is open at the current BeginPopup() level of the popup stack.
 // IsPopupOpenV(id, PopupFlagsAnyPopup): return true if any popup is open.
 func IsPopupOpenV(id string, flags PopupFlags) bool {
-	idArg, idFin := wrapString(id)
+	idArg, idFin := wrapString(shapedLabel(id))
 	defer idFin()
 	return C.iggIsPopupOpen(idArg, C.int(flags)) != 0
 }
@@ -27,9 +27,17 @@ type OpenGL3 struct {
 	vboHandle              uint32
 	elementsHandle         uint32
 
+	sdfShaderHandle          uint32
+	sdfFragHandle            uint32
+	sdfAttribLocationTex     int32
+	sdfAttribLocationProjMtx int32
+	fontSDF                  *FontAtlasSDF
+
 	contentScale     float32
 	textureMinFilter int32
 	textureMagFilter int32
+
+	fontTextureBuilder FontTextureBuilder
 }
 
 // Texture filtering types.
@@ -73,7 +81,10 @@ func (renderer *OpenGL3) PreRender(clearColor [4]float32) {
 }
 
 // Render translates the ImGui draw data to OpenGL3 commands.
+// A font texture rebuild that was requested with RebuildFontTexture() is done after the draw data was rendered.
 func (renderer *OpenGL3) Render(displaySize [2]float32, framebufferSize [2]float32, drawData DrawData) {
+	defer renderer.rebuildRequestedFontTexture()
+
 	// Avoid rendering when minimized, scale coordinates for retina displays (screen coordinates != framebuffer coordinates)
 	displayWidth, displayHeight := displaySize[0], displaySize[1]
 	fbWidth, fbHeight := framebufferSize[0], framebufferSize[1]
@@ -143,9 +154,13 @@ func (renderer *OpenGL3) Render(displaySize [2]float32, framebufferSize [2]float
 		{0.0, 0.0, -1.0, 0.0},
 		{-1.0, 1.0, 0.0, 1.0},
 	}
+	gl.UseProgram(renderer.sdfShaderHandle)
+	gl.Uniform1i(renderer.sdfAttribLocationTex, 0)
+	gl.UniformMatrix4fv(renderer.sdfAttribLocationProjMtx, 1, false, &orthoProjection[0][0])
 	gl.UseProgram(renderer.shaderHandle)
 	gl.Uniform1i(renderer.attribLocationTex, 0)
 	gl.UniformMatrix4fv(renderer.attribLocationProjMtx, 1, false, &orthoProjection[0][0])
+	currentShaderHandle := renderer.shaderHandle
 	gl.BindSampler(0, 0) // Rely on combined texture/sampler state.
 
 	// Recreate the VAO every time
@@ -184,7 +199,17 @@ func (renderer *OpenGL3) Render(displaySize [2]float32, framebufferSize [2]float
 			if cmd.HasUserCallback() {
 				cmd.CallUserCallback(list)
 			} else {
-				gl.BindTexture(gl.TEXTURE_2D, uint32(cmd.TextureID()))
+				textureID := cmd.TextureID()
+				shaderHandle := renderer.shaderHandle
+				if (textureID & TextureIDSignedDistanceField) != 0 {
+					shaderHandle = renderer.sdfShaderHandle
+					textureID &^= TextureIDSignedDistanceField
+				}
+				if shaderHandle != currentShaderHandle {
+					gl.UseProgram(shaderHandle)
+					currentShaderHandle = shaderHandle
+				}
+				gl.BindTexture(gl.TEXTURE_2D, uint32(textureID))
 				gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, renderer.textureMinFilter) // minification filter
 				gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, renderer.textureMagFilter) // magnification filter
 				clipRect := cmd.ClipRect()
@@ -289,6 +314,34 @@ void main()
 	renderer.attribLocationUV = gl.GetAttribLocation(renderer.shaderHandle, gl.Str("UV"+"\x00"))
 	renderer.attribLocationColor = gl.GetAttribLocation(renderer.shaderHandle, gl.Str("Color"+"\x00"))
 
+	// The shader for signed distance fields shares the vertex shader, with the same attribute locations.
+	// The edge of a glyph is at alpha 0.5, smoothed over the width of a screen pixel.
+	sdfFragmentShader := renderer.glslVersion + `
+uniform sampler2D Texture;
+in vec2 Frag_UV;
+in vec4 Frag_Color;
+out vec4 Out_Color;
+void main()
+{
+	vec4 texel = texture(Texture, Frag_UV);
+	float width = max(fwidth(texel.a) * 0.7, 0.0001);
+	float alpha = smoothstep(0.5 - width, 0.5 + width, texel.a);
+	Out_Color = Frag_Color * vec4(texel.rgb, alpha);
+}
+`
+	renderer.sdfShaderHandle = gl.CreateProgram()
+	renderer.sdfFragHandle = gl.CreateShader(gl.FRAGMENT_SHADER)
+	glShaderSource(renderer.sdfFragHandle, sdfFragmentShader)
+	gl.CompileShader(renderer.sdfFragHandle)
+	gl.AttachShader(renderer.sdfShaderHandle, renderer.vertHandle)
+	gl.AttachShader(renderer.sdfShaderHandle, renderer.sdfFragHandle)
+	gl.BindAttribLocation(renderer.sdfShaderHandle, uint32(renderer.attribLocationPosition), gl.Str("Position"+"\x00"))
+	gl.BindAttribLocation(renderer.sdfShaderHandle, uint32(renderer.attribLocationUV), gl.Str("UV"+"\x00"))
+	gl.BindAttribLocation(renderer.sdfShaderHandle, uint32(renderer.attribLocationColor), gl.Str("Color"+"\x00"))
+	gl.LinkProgram(renderer.sdfShaderHandle)
+	renderer.sdfAttribLocationTex = gl.GetUniformLocation(renderer.sdfShaderHandle, gl.Str("Texture"+"\x00"))
+	renderer.sdfAttribLocationProjMtx = gl.GetUniformLocation(renderer.sdfShaderHandle, gl.Str("ProjMtx"+"\x00"))
+
 	gl.GenBuffers(1, &renderer.vboHandle)
 	gl.GenBuffers(1, &renderer.elementsHandle)
 
@@ -321,7 +374,62 @@ func (renderer *OpenGL3) createFontsTexture() {
 		fonts.AddFontDefaultV(fontConfig)
 	}
 
-	image := fonts.TextureDataRGBA32()
+	renderer.uploadFontsTexture()
+}
+
+// ReloadFontTexture releases the current font texture and uploads the texture data of the font atlas again,
+// building the atlas if necessary. Call it between frames, after the atlas was rebuilt, for example by a
+// DynamicFontAtlas.
+func (renderer *OpenGL3) ReloadFontTexture() {
+	if renderer.fontTexture != 0 {
+		gl.DeleteTextures(1, &renderer.fontTexture)
+		renderer.fontTexture = 0
+	}
+	renderer.uploadFontsTexture()
+}
+
+// RebuildFontTexture requests the font atlas to be rebuilt and uploaded again at the end of the next Render(),
+// which is a safe point between frames. The old texture is released and the new one set in the atlas.
+//
+// The optional update function is called right before the atlas is built, with the atlas not being in use.
+// It can change the fonts, for example clear the atlas and add the fonts again with a different size.
+// Fonts that were returned before the atlas was cleared must not be used anymore; use the ones added by update.
+// If RebuildFontTexture is called several times before the next Render(), all update functions are called in order.
+func (renderer *OpenGL3) RebuildFontTexture(update func(atlas FontAtlas)) {
+	renderer.fontTextureBuilder.RequestRebuild(update)
+}
+
+// AddFontRasterizer adds a rasterizer that writes into the texture data of the font atlas after each build,
+// before the texture is uploaded. Use it for FontAtlasImages and bitmap fonts, whose pixels would otherwise
+// be lost when the atlas is rebuilt, for example by RebuildFontTexture() or a DynamicFontAtlas.
+// The font texture is uploaded again, with the pixels of the rasterizer.
+func (renderer *OpenGL3) AddFontRasterizer(rasterizer FontAtlasRasterizer) {
+	renderer.fontTextureBuilder.AddRasterizer(rasterizer)
+	renderer.ReloadFontTexture()
+}
+
+func (renderer *OpenGL3) rebuildRequestedFontTexture() {
+	if renderer.fontTextureBuilder.ApplyRequestedRebuild(CurrentIO().Fonts()) {
+		renderer.ReloadFontTexture()
+	}
+}
+
+// SetFontAtlasSDF sets the distance fields to render the font atlas with, and uploads the font texture again.
+// The atlas is built with FontAtlasSDF.Build() from then on, and the texture is drawn with a shader for
+// signed distance fields. A nil value returns to regular rendering. The atlas of sdf must be the one of the
+// current context.
+func (renderer *OpenGL3) SetFontAtlasSDF(sdf *FontAtlasSDF) {
+	renderer.fontSDF = sdf
+	renderer.ReloadFontTexture()
+}
+
+func (renderer *OpenGL3) uploadFontsTexture() {
+	io := CurrentIO()
+	image := renderer.fontTextureBuilder.TextureData(io.Fonts(), renderer.fontSDF)
+	textureFlags := TextureID(0)
+	if renderer.fontSDF != nil {
+		textureFlags = TextureIDSignedDistanceField
+	}
 
 	// Upload texture to graphics system
 	var lastTexture int32
@@ -334,7 +442,7 @@ func (renderer *OpenGL3) createFontsTexture() {
 	gl.TexImage2D(gl.TEXTURE_2D, 0, gl.RGBA, int32(image.Width), int32(image.Height), 0, gl.RGBA, gl.UNSIGNED_BYTE, image.Pixels)
 
 	// Store our identifier
-	io.Fonts().SetTextureID(TextureID(renderer.fontTexture))
+	io.Fonts().SetTextureID(TextureID(renderer.fontTexture) | textureFlags)
 
 	// Restore state
 	gl.BindTexture(gl.TEXTURE_2D, uint32(lastTexture))
@@ -350,6 +458,21 @@ func (renderer *OpenGL3) invalidateDeviceObjects() {
 	}
 	renderer.elementsHandle = 0
 
+	if (renderer.sdfShaderHandle != 0) && (renderer.vertHandle != 0) {
+		gl.DetachShader(renderer.sdfShaderHandle, renderer.vertHandle)
+	}
+	if (renderer.sdfShaderHandle != 0) && (renderer.sdfFragHandle != 0) {
+		gl.DetachShader(renderer.sdfShaderHandle, renderer.sdfFragHandle)
+	}
+	if renderer.sdfFragHandle != 0 {
+		gl.DeleteShader(renderer.sdfFragHandle)
+	}
+	renderer.sdfFragHandle = 0
+	if renderer.sdfShaderHandle != 0 {
+		gl.DeleteProgram(renderer.sdfShaderHandle)
+	}
+	renderer.sdfShaderHandle = 0
+
 	if (renderer.shaderHandle != 0) && (renderer.vertHandle != 0) {
 		gl.DetachShader(renderer.shaderHandle, renderer.vertHandle)
 	}
@@ -0,0 +1,315 @@
+package imgui
+
+import "sort"
+
+// SelectionModel tracks the selected items of a list, such as Selectable items, table rows or ranges of a ListClipper.
+// Items are identified by their index in the list.
+//
+// The model implements the common interactions: A click selects a single item, control-click toggles an item,
+// shift-click selects the range from the anchor to the clicked item, and control-A selects all items.
+// The arrow, home and end keys move the focus and, with shift, extend the selection from the anchor.
+//
+// The selection is stored as ranges, so selecting all items of a huge list, or a range that includes items
+// that were never submitted because they were clipped, is cheap.
+// The zero value is an empty selection, ready to use.
+//
+// Usage:
+//   var selection imgui.SelectionModel
+//   ...
+//   var clipper imgui.ListClipper
+//   clipper.Begin(len(items))
+//   for clipper.Step() {
+//       for i := clipper.DisplayStart; i < clipper.DisplayEnd; i++ {
+//           selection.Selectable(items[i], i)
+//       }
+//   }
+//   selection.HandleKeyboard(len(items))
+type SelectionModel struct {
+	ranges []selectionRange
+
+	anchor   int
+	focus    int
+	hasFocus bool
+
+	scrollToFocus bool
+}
+
+// selectionRange covers the items from start, inclusive, to end, exclusive.
+type selectionRange struct {
+	start int
+	end   int
+}
+
+// IsSelected returns whether the item with given index is selected.
+func (model *SelectionModel) IsSelected(index int) bool {
+	i := model.rangeIndexAfter(index)
+	return (i > 0) && (index < model.ranges[i-1].end)
+}
+
+// rangeIndexAfter returns the index of the first range that starts after given item index.
+func (model *SelectionModel) rangeIndexAfter(index int) int {
+	return sort.Search(len(model.ranges), func(i int) bool { return model.ranges[i].start > index })
+}
+
+// SetSelected changes the selection state of a single item.
+func (model *SelectionModel) SetSelected(index int, selected bool) {
+	model.SetRangeSelected(index, index, selected)
+}
+
+// SetRangeSelected changes the selection state of all items from first to last, inclusive.
+// The two indices may be given in any order.
+func (model *SelectionModel) SetRangeSelected(first, last int, selected bool) {
+	if first > last {
+		first, last = last, first
+	}
+	start, end := first, last+1
+	merged := make([]selectionRange, 0, len(model.ranges)+1)
+	for _, r := range model.ranges {
+		switch {
+		case selected && (r.end >= start) && (r.start <= end):
+			// Overlapping or adjacent: absorb into the new range.
+			if r.start < start {
+				start = r.start
+			}
+			if r.end > end {
+				end = r.end
+			}
+		case !selected && (r.end > start) && (r.start < end):
+			if r.start < start {
+				merged = append(merged, selectionRange{start: r.start, end: start})
+			}
+			if r.end > end {
+				merged = append(merged, selectionRange{start: end, end: r.end})
+			}
+		default:
+			merged = append(merged, r)
+		}
+	}
+	if selected {
+		merged = append(merged, selectionRange{start: start, end: end})
+		sort.Slice(merged, func(i, j int) bool { return merged[i].start < merged[j].start })
+	}
+	model.ranges = merged
+}
+
+// SelectAll selects all items of a list with given count.
+func (model *SelectionModel) SelectAll(count int) {
+	model.Clear()
+	if count > 0 {
+		model.ranges = []selectionRange{{start: 0, end: count}}
+	}
+}
+
+// Clear deselects all items. The anchor and focus are kept.
+func (model *SelectionModel) Clear() {
+	model.ranges = nil
+}
+
+// Reset deselects all items and removes the anchor and focus.
+func (model *SelectionModel) Reset() {
+	model.Clear()
+	model.hasFocus = false
+	model.scrollToFocus = false
+}
+
+// Count returns the number of selected items.
+func (model *SelectionModel) Count() int {
+	count := 0
+	for _, r := range model.ranges {
+		count += r.end - r.start
+	}
+	return count
+}
+
+// Each calls the given function for every selected item, in ascending order.
+// The iteration stops if the function returns false.
+func (model *SelectionModel) Each(fn func(index int) bool) {
+	for _, r := range model.ranges {
+		for index := r.start; index < r.end; index++ {
+			if !fn(index) {
+				return
+			}
+		}
+	}
+}
+
+// Indices returns the selected items, in ascending order.
+func (model *SelectionModel) Indices() []int {
+	indices := make([]int, 0, model.Count())
+	model.Each(func(index int) bool {
+		indices = append(indices, index)
+		return true
+	})
+	return indices
+}
+
+// setIndices replaces the selection with the given items, which must be sorted in ascending order.
+func (model *SelectionModel) setIndices(indices []int) {
+	model.ranges = nil
+	for _, index := range indices {
+		last := len(model.ranges) - 1
+		if (last >= 0) && (model.ranges[last].end == index) {
+			model.ranges[last].end++
+		} else {
+			model.ranges = append(model.ranges, selectionRange{start: index, end: index + 1})
+		}
+	}
+}
+
+// Anchor returns the item from which range selections start, or -1 if there is none.
+func (model *SelectionModel) Anchor() int {
+	if !model.hasFocus {
+		return -1
+	}
+	return model.anchor
+}
+
+// Focus returns the item that was last clicked or navigated to, or -1 if there is none.
+func (model *SelectionModel) Focus() int {
+	if !model.hasFocus {
+		return -1
+	}
+	return model.focus
+}
+
+// Click applies a click on given item, with the state of the control and shift modifiers.
+func (model *SelectionModel) Click(index int, ctrl, shift bool) {
+	switch {
+	case shift && model.hasFocus:
+		if !ctrl {
+			model.Clear()
+		}
+		model.SetRangeSelected(model.anchor, index, true)
+		model.focus = index
+	case ctrl:
+		model.SetSelected(index, !model.IsSelected(index))
+		model.setAnchor(index)
+	default:
+		model.Clear()
+		model.SetSelected(index, true)
+		model.setAnchor(index)
+	}
+}
+
+// MoveFocus moves the focus to given item, as done by keyboard navigation.
+// Without modifiers, only the new item is selected. With shift, the range from the anchor is selected.
+// With control only, the focus is moved without changing the selection.
+func (model *SelectionModel) MoveFocus(index int, ctrl, shift bool) {
+	switch {
+	case shift:
+		if !model.hasFocus {
+			model.setAnchor(index)
+		}
+		if !ctrl {
+			model.Clear()
+		}
+		model.SetRangeSelected(model.anchor, index, true)
+		model.focus = index
+	case ctrl:
+		if !model.hasFocus {
+			model.anchor = index
+		}
+		model.focus = index
+		model.hasFocus = true
+	default:
+		model.Clear()
+		model.SetSelected(index, true)
+		model.setAnchor(index)
+	}
+	model.scrollToFocus = true
+}
+
+func (model *SelectionModel) setAnchor(index int) {
+	model.anchor = index
+	model.focus = index
+	model.hasFocus = true
+}
+
+// SelectableV submits a SelectableV() for given item, showing its selection state.
+// A click is applied to the model with the current keyboard modifiers.
+// It returns true if the item was clicked.
+func (model *SelectionModel) SelectableV(label string, index int, flags SelectableFlags, size Vec2) bool {
+	clicked := SelectableV(label, model.IsSelected(index), flags, size)
+	if clicked {
+		io := CurrentIO()
+		model.Click(index, io.KeyCtrlPressed(), io.KeyShiftPressed())
+	}
+	return clicked
+}
+
+// Selectable calls SelectableV(label, index, 0, Vec2{0, 0}).
+func (model *SelectionModel) Selectable(label string, index int) bool {
+	return model.SelectableV(label, index, 0, Vec2{})
+}
+
+// HandleKeyboard applies keyboard navigation to a list with given count of items.
+// It is only active while the current window is focused and no item is active, for example an InputText().
+// Call it within the window of the list, such as after the items of a BeginListBox() or within a BeginTable().
+// It returns true if the selection or focus changed.
+func (model *SelectionModel) HandleKeyboard(count int) bool {
+	if (count <= 0) || !IsWindowFocused() || IsAnyItemActive() {
+		return false
+	}
+	io := CurrentIO()
+	ctrl, shift := io.KeyCtrlPressed(), io.KeyShiftPressed()
+	if ctrl && IsKeyPressedV(KeyIndex(KeyA), false) {
+		model.SelectAll(count)
+		return true
+	}
+
+	if model.hasFocus && (model.focus >= count) {
+		// The list shrank: Keep the focus within it, without selecting anything.
+		model.focus = count - 1
+		if model.anchor >= count {
+			model.anchor = count - 1
+		}
+	}
+	focus := model.Focus()
+	target := focus
+	pressed := false
+	switch {
+	case IsKeyPressed(KeyIndex(KeyUpArrow)):
+		target, pressed = focus-1, true
+	case IsKeyPressed(KeyIndex(KeyDownArrow)):
+		target, pressed = focus+1, true
+	case IsKeyPressed(KeyIndex(KeyHome)):
+		target, pressed = 0, true
+	case IsKeyPressed(KeyIndex(KeyEnd)):
+		target, pressed = count-1, true
+	case ctrl && IsKeyPressedV(KeyIndex(KeySpace), false) && (focus >= 0):
+		model.Click(focus, true, false)
+		return true
+	}
+	if !pressed {
+		return false
+	}
+	if target < 0 {
+		target = 0
+	}
+	if target >= count {
+		target = count - 1
+	}
+	if target == focus {
+		return false
+	}
+	model.MoveFocus(target, ctrl, shift)
+	return true
+}
+
+// ScrollToFocus scrolls the current window to show the focused item if the focus was moved by keyboard since the
+// last call. It is meant for lists of evenly spaced items, as they are used with a ListClipper, where the focused item
+// may not be submitted at all. firstItemPosY is the window-local position of the first item when not scrolled,
+// and itemHeight the distance between the items, such as ListClipper.ItemsHeight.
+func (model *SelectionModel) ScrollToFocus(firstItemPosY, itemHeight float32) {
+	if !model.scrollToFocus || !model.hasFocus || (itemHeight <= 0) {
+		return
+	}
+	model.scrollToFocus = false
+	scroll := ScrollY()
+	itemTop := float32(model.focus) * itemHeight
+	if itemTop < scroll {
+		SetScrollY(itemTop)
+	} else if itemBottom := firstItemPosY + itemTop + itemHeight; itemBottom > scroll+WindowHeight() {
+		SetScrollY(itemBottom - WindowHeight())
+	}
+}
@@ -0,0 +1,97 @@
+package imgui_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ianling/imgui-go"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectionModelClicks(t *testing.T) {
+	var model imgui.SelectionModel
+
+	model.Click(2, false, false)
+	assert.Equal(t, []int{2}, model.Indices(), "Plain click selects single item")
+	model.Click(5, false, true)
+	assert.Equal(t, []int{2, 3, 4, 5}, model.Indices(), "Shift-click selects range from anchor")
+	model.Click(0, false, true)
+	assert.Equal(t, []int{0, 1, 2}, model.Indices(), "Shift-click replaces previous range")
+	model.Click(8, true, false)
+	assert.Equal(t, []int{0, 1, 2, 8}, model.Indices(), "Control-click adds item")
+	model.Click(10, true, true)
+	assert.Equal(t, []int{0, 1, 2, 8, 9, 10}, model.Indices(), "Control-shift-click adds range from new anchor")
+	model.Click(1, true, false)
+	assert.Equal(t, []int{0, 2, 8, 9, 10}, model.Indices(), "Control-click toggles item")
+	assert.Equal(t, 1, model.Anchor())
+	assert.Equal(t, 1, model.Focus())
+}
+
+func TestSelectionModelRanges(t *testing.T) {
+	var model imgui.SelectionModel
+
+	model.SelectAll(1000000)
+	assert.Equal(t, 1000000, model.Count())
+	model.SetRangeSelected(20, 10, false)
+	assert.Equal(t, 1000000-11, model.Count())
+	assert.True(t, model.IsSelected(9))
+	assert.False(t, model.IsSelected(10))
+	assert.False(t, model.IsSelected(20))
+	assert.True(t, model.IsSelected(21))
+	model.SetRangeSelected(5, 25, true)
+	assert.Equal(t, 1000000, model.Count(), "Ranges should merge")
+
+	model.Clear()
+	assert.Equal(t, 0, model.Count())
+	assert.Equal(t, -1, model.Focus(), "Focus is not set by range functions")
+}
+
+func TestSelectionModelMoveFocus(t *testing.T) {
+	var model imgui.SelectionModel
+
+	model.MoveFocus(3, false, false)
+	model.MoveFocus(5, false, true)
+	assert.Equal(t, []int{3, 4, 5}, model.Indices())
+	model.MoveFocus(7, true, false)
+	assert.Equal(t, []int{3, 4, 5}, model.Indices(), "Control moves focus only")
+	assert.Equal(t, 7, model.Focus())
+	assert.Equal(t, 3, model.Anchor())
+
+	model.Reset()
+	assert.Equal(t, -1, model.Focus())
+	assert.Equal(t, 0, model.Count())
+}
+
+func TestSelectionModelHandleKeyboardWithoutInput(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 400, Y: 300})
+	defer context.Destroy()
+	io := imgui.CurrentIO()
+	const keyUp = 1
+	io.KeyMap(imgui.KeyUpArrow, keyUp)
+
+	var model imgui.SelectionModel
+	render := func(count int) (changed bool) {
+		renderTestWindow(func() {
+			for index := 0; index < count; index++ {
+				model.Selectable(fmt.Sprintf("Item %d", index), index)
+			}
+			changed = model.HandleKeyboard(count)
+		})
+		return changed
+	}
+	for frame := 0; frame < 3; frame++ {
+		assert.False(t, render(5), "Focused list should not change without input")
+	}
+	assert.Equal(t, 0, model.Count(), "Nothing should be selected without input")
+
+	model.MoveFocus(8, false, false)
+	assert.False(t, render(5), "Shrinking the list should not change the selection")
+	assert.Equal(t, []int{8}, model.Indices())
+	assert.Equal(t, 4, model.Focus(), "Focus should be kept within the list")
+
+	io.KeyPress(keyUp)
+	assert.True(t, render(5))
+	io.KeyRelease(keyUp)
+	assert.Equal(t, []int{3}, model.Indices())
+}
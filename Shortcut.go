@@ -0,0 +1,663 @@
+package imgui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// KeyModifiers is a combination of modifier keys held down for a KeyChord.
+type KeyModifiers uint8
+
+// This is a list of KeyModifiers flags.
+const (
+	KeyModifierCtrl KeyModifiers = 1 << iota
+	KeyModifierShift
+	KeyModifierAlt
+	KeyModifierSuper
+	KeyModifiersNone KeyModifiers = 0
+)
+
+var keyModifierNames = []struct {
+	modifier KeyModifiers
+	names    []string
+}{
+	{modifier: KeyModifierCtrl, names: []string{"Ctrl", "Control"}},
+	{modifier: KeyModifierShift, names: []string{"Shift"}},
+	{modifier: KeyModifierAlt, names: []string{"Alt", "Option"}},
+	{modifier: KeyModifierSuper, names: []string{"Super", "Cmd", "Command", "Win", "Meta"}},
+}
+
+// shortcutNamedKeys maps the names of the keys known to imgui to their imgui key, as used for IO.KeyMap().
+var shortcutNamedKeys = map[string]int{
+	"Tab":       KeyTab,
+	"Left":      KeyLeftArrow,
+	"Right":     KeyRightArrow,
+	"Up":        KeyUpArrow,
+	"Down":      KeyDownArrow,
+	"PageUp":    KeyPageUp,
+	"PageDown":  KeyPageDown,
+	"Home":      KeyHome,
+	"End":       KeyEnd,
+	"Insert":    KeyInsert,
+	"Delete":    KeyDelete,
+	"Backspace": KeyBackspace,
+	"Space":     KeySpace,
+	"Enter":     KeyEnter,
+	"Escape":    KeyEscape,
+}
+
+// shortcutPrintableKeys are the printable keys that GLFW reports, with their ASCII code as key code.
+// Characters that are only typed with Shift, such as '+' or '!', have no key of their own.
+const shortcutPrintableKeys = "',-./0123456789;=ABCDEFGHIJKLMNOPQRSTUVWXYZ[\\]`"
+
+var shortcutKeyAliases = map[string]string{
+	"esc":    "Escape",
+	"del":    "Delete",
+	"ins":    "Insert",
+	"return": "Enter",
+	"pgup":   "PageUp",
+	"pgdn":   "PageDown",
+}
+
+// KeyChord is a single key pressed together with modifier keys, such as "Ctrl+Shift+S".
+type KeyChord struct {
+	Modifiers KeyModifiers
+	// Key is the name of the key, such as "S", "F1" or "Enter".
+	Key string
+}
+
+// String returns the chord in the form "Ctrl+Shift+Alt+Super+Key".
+func (chord KeyChord) String() string {
+	var parts []string
+	for _, entry := range keyModifierNames {
+		if chord.Modifiers&entry.modifier != 0 {
+			parts = append(parts, entry.names[0])
+		}
+	}
+	return strings.Join(append(parts, chord.Key), "+")
+}
+
+// Shortcut is a sequence of chords that have to be pressed one after the other, such as "Ctrl+K Ctrl+C".
+// Most shortcuts consist of only one chord. An empty shortcut is not bound to any keys.
+type Shortcut []KeyChord
+
+// String returns the shortcut in the form accepted by ParseShortcut(), for example to display it with MenuItemV().
+func (shortcut Shortcut) String() string {
+	chords := make([]string, len(shortcut))
+	for index, chord := range shortcut {
+		chords[index] = chord.String()
+	}
+	return strings.Join(chords, " ")
+}
+
+// Equal returns whether both shortcuts consist of the same chords.
+func (shortcut Shortcut) Equal(other Shortcut) bool {
+	return (len(shortcut) == len(other)) && shortcut.hasPrefix(other)
+}
+
+func (shortcut Shortcut) hasPrefix(prefix Shortcut) bool {
+	if len(prefix) > len(shortcut) {
+		return false
+	}
+	for index, chord := range prefix {
+		if shortcut[index] != chord {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseShortcut parses a shortcut such as "Ctrl+Shift+S", or a sequence of chords such as "Ctrl+K Ctrl+C".
+// Modifiers and key names are case insensitive. Single character keys are upper-cased, and common aliases
+// such as "Esc" or "Cmd" are accepted. An empty text results in an empty shortcut.
+func ParseShortcut(text string) (Shortcut, error) {
+	var shortcut Shortcut
+	for _, field := range strings.Fields(text) {
+		chord, err := parseKeyChord(field)
+		if err != nil {
+			return nil, err
+		}
+		shortcut = append(shortcut, chord)
+	}
+	return shortcut, nil
+}
+
+func parseKeyChord(text string) (KeyChord, error) {
+	var chord KeyChord
+	parts := strings.Split(text, "+")
+	// A trailing empty part is the plus key itself, as in "Ctrl++".
+	if (len(parts) > 1) && (parts[len(parts)-1] == "") && (parts[len(parts)-2] == "") {
+		parts = append(parts[:len(parts)-2], "+")
+	}
+	for index, part := range parts {
+		if index == len(parts)-1 {
+			key, err := normalizeShortcutKey(part)
+			if err != nil {
+				return KeyChord{}, fmt.Errorf("invalid shortcut %q: %v", text, err)
+			}
+			chord.Key = key
+			break
+		}
+		modifier := parseKeyModifier(part)
+		if modifier == KeyModifiersNone {
+			return KeyChord{}, fmt.Errorf("invalid shortcut %q: unknown modifier %q", text, part)
+		}
+		chord.Modifiers |= modifier
+	}
+	return chord, nil
+}
+
+func parseKeyModifier(text string) KeyModifiers {
+	for _, entry := range keyModifierNames {
+		for _, name := range entry.names {
+			if strings.EqualFold(name, text) {
+				return entry.modifier
+			}
+		}
+	}
+	return KeyModifiersNone
+}
+
+func normalizeShortcutKey(text string) (string, error) {
+	if text == "" {
+		return "", errors.New("missing key")
+	}
+	if parseKeyModifier(text) != KeyModifiersNone {
+		return "", fmt.Errorf("modifier %q without key", text)
+	}
+	if len([]rune(text)) == 1 {
+		return strings.ToUpper(text), nil
+	}
+	if alias, known := shortcutKeyAliases[strings.ToLower(text)]; known {
+		return alias, nil
+	}
+	for name := range shortcutNamedKeys {
+		if strings.EqualFold(name, text) {
+			return name, nil
+		}
+	}
+	if number, err := strconv.Atoi(text[1:]); (err == nil) && ((text[0] == 'F') || (text[0] == 'f')) && (number > 0) {
+		return "F" + strconv.Itoa(number), nil
+	}
+	return text, nil
+}
+
+// ShortcutScope determines when the shortcut of an action is reported as pressed.
+type ShortcutScope int
+
+// This is a list of ShortcutScope values.
+const (
+	// ShortcutScopeGlobal reports the shortcut regardless of focus.
+	ShortcutScopeGlobal ShortcutScope = iota
+	// ShortcutScopeWindow reports the shortcut only while the current window, or one of its children, is focused.
+	ShortcutScopeWindow
+	// ShortcutScopeItem reports the shortcut only while the last item is focused.
+	ShortcutScopeItem
+)
+
+// ShortcutConflict describes two actions that can not be told apart by their shortcuts:
+// Either both have the same shortcut, or the shortcut of the first is the start of the chord sequence of the second.
+type ShortcutConflict struct {
+	Actions  [2]string
+	Shortcut Shortcut
+}
+
+type shortcutBinding struct {
+	action          string
+	scope           ShortcutScope
+	defaultShortcut Shortcut
+}
+
+// ShortcutManager is a registry of the keyboard shortcuts of named actions.
+//
+// Actions are registered with a default shortcut and a scope. Call Update() once per frame, after NewFrame(),
+// and query Pressed() where the action is handled, within the window or after the item of its scope.
+// The shortcuts can be rebound by the user with Bind() or RenderEditor(), and the rebindings can be
+// persisted with Save() and Load().
+//
+// Keys are identified by name. The names of the keys known to imgui, such as "Enter", "Escape" or "PageUp",
+// are resolved with KeyIndex(). All other keys need to be in the Keys map, which initially contains the letters,
+// digits and punctuation keys with their ASCII code as native key, as is the case for GLFW. Characters that are typed
+// with Shift, such as "+" or "!", are no keys; use their unshifted key with the Shift modifier instead.
+// Use GLFW.ShortcutKeys(), or a similar table of your platform, to add function keys.
+//
+// Shortcuts without Ctrl, Alt or Super modifier are ignored while text is entered.
+//
+// Usage:
+//   shortcuts := imgui.NewShortcutManager()
+//   _ = shortcuts.Register("File: Save", "Ctrl+S", imgui.ShortcutScopeGlobal)
+//   _ = shortcuts.Register("Edit: Comment", "Ctrl+K Ctrl+C", imgui.ShortcutScopeWindow)
+//   ...
+//   imgui.NewFrame()
+//   shortcuts.Update()
+//   if imgui.MenuItemV("Save", shortcuts.Text("File: Save"), false, true) || shortcuts.Pressed("File: Save") {
+//       save()
+//   }
+type ShortcutManager struct {
+	// Keys maps the names of keys to native key indices, as passed to IO.KeyPress().
+	Keys map[string]int
+
+	bindings  []*shortcutBinding
+	overrides map[string]Shortcut
+
+	pending   Shortcut
+	triggered Shortcut
+
+	recording  string
+	edits      map[string]string
+	editErrors map[string]error
+}
+
+// NewShortcutManager returns a manager without actions.
+func NewShortcutManager() *ShortcutManager {
+	keys := make(map[string]int)
+	for _, code := range shortcutPrintableKeys {
+		keys[string(code)] = int(code)
+	}
+	return &ShortcutManager{
+		Keys:       keys,
+		overrides:  make(map[string]Shortcut),
+		edits:      make(map[string]string),
+		editErrors: make(map[string]error),
+	}
+}
+
+func (manager *ShortcutManager) binding(action string) *shortcutBinding {
+	for _, binding := range manager.bindings {
+		if binding.action == action {
+			return binding
+		}
+	}
+	return nil
+}
+
+func (manager *ShortcutManager) nativeKey(name string) (int, bool) {
+	if key, known := manager.Keys[name]; known {
+		return key, true
+	}
+	if key, known := shortcutNamedKeys[name]; known {
+		if index := KeyIndex(key); index >= 0 {
+			return index, true
+		}
+	}
+	return 0, false
+}
+
+func (manager *ShortcutManager) parse(text string) (Shortcut, error) {
+	shortcut, err := ParseShortcut(text)
+	if err != nil {
+		return nil, err
+	}
+	for _, chord := range shortcut {
+		if _, known := manager.Keys[chord.Key]; !known {
+			if _, named := shortcutNamedKeys[chord.Key]; !named {
+				return nil, fmt.Errorf("invalid shortcut %q: unknown key %q", text, chord.Key)
+			}
+		}
+	}
+	return shortcut, nil
+}
+
+// Register adds an action with its default shortcut, which may be empty. Registering an action again replaces
+// its default and scope. A rebinding, such as one that was loaded before registering, takes precedence.
+func (manager *ShortcutManager) Register(action string, defaultShortcut string, scope ShortcutScope) error {
+	shortcut, err := manager.parse(defaultShortcut)
+	if err != nil {
+		return err
+	}
+	binding := manager.binding(action)
+	if binding == nil {
+		binding = &shortcutBinding{action: action}
+		manager.bindings = append(manager.bindings, binding)
+	}
+	binding.scope = scope
+	binding.defaultShortcut = shortcut
+	return nil
+}
+
+// Unregister removes an action. Its rebinding is kept.
+func (manager *ShortcutManager) Unregister(action string) {
+	for index, binding := range manager.bindings {
+		if binding.action == action {
+			manager.bindings = append(manager.bindings[:index], manager.bindings[index+1:]...)
+			return
+		}
+	}
+}
+
+// Actions returns the registered actions in order of registration.
+func (manager *ShortcutManager) Actions() []string {
+	actions := make([]string, len(manager.bindings))
+	for index, binding := range manager.bindings {
+		actions[index] = binding.action
+	}
+	return actions
+}
+
+// Shortcut returns the current shortcut of an action: its rebinding, if any, or else its default.
+func (manager *ShortcutManager) Shortcut(action string) Shortcut {
+	if shortcut, rebound := manager.overrides[action]; rebound {
+		return shortcut
+	}
+	if binding := manager.binding(action); binding != nil {
+		return binding.defaultShortcut
+	}
+	return nil
+}
+
+// Text returns the current shortcut of an action as text, as used for the shortcut parameter of MenuItemV().
+func (manager *ShortcutManager) Text(action string) string {
+	return manager.Shortcut(action).String()
+}
+
+// Bind rebinds an action to given shortcut. An empty text leaves the action without shortcut.
+// Use Conflicts() to check whether the new shortcut is in conflict with another action.
+func (manager *ShortcutManager) Bind(action string, shortcut string) error {
+	binding := manager.binding(action)
+	if binding == nil {
+		return fmt.Errorf("unknown action %q", action)
+	}
+	parsed, err := manager.parse(shortcut)
+	if err != nil {
+		return err
+	}
+	if parsed.Equal(binding.defaultShortcut) {
+		delete(manager.overrides, action)
+	} else {
+		manager.overrides[action] = parsed
+	}
+	return nil
+}
+
+// Reset restores the default shortcut of an action.
+func (manager *ShortcutManager) Reset(action string) {
+	delete(manager.overrides, action)
+}
+
+// ResetAll restores the default shortcuts of all actions.
+func (manager *ShortcutManager) ResetAll() {
+	manager.overrides = make(map[string]Shortcut)
+}
+
+// IsRebound returns whether the action has a shortcut other than its default.
+func (manager *ShortcutManager) IsRebound(action string) bool {
+	_, rebound := manager.overrides[action]
+	return rebound
+}
+
+func shortcutScopesOverlap(a, b ShortcutScope) bool {
+	return (a == b) || (a == ShortcutScopeGlobal) || (b == ShortcutScopeGlobal)
+}
+
+// Conflicts returns all pairs of actions that are in conflict with each other.
+//
+// Actions with the same shortcut are in conflict if their scopes overlap, that is, if they have the same scope or
+// one of them is global. Two windows may use the same shortcut for different window scoped actions, though,
+// which is reported as conflict as well. An action whose shortcut is the start of the chord sequence of
+// another action is always in conflict, as the longer sequence can then not be entered.
+func (manager *ShortcutManager) Conflicts() []ShortcutConflict {
+	var conflicts []ShortcutConflict
+	for index, first := range manager.bindings {
+		firstShortcut := manager.Shortcut(first.action)
+		if len(firstShortcut) == 0 {
+			continue
+		}
+		for _, second := range manager.bindings[index+1:] {
+			secondShortcut := manager.Shortcut(second.action)
+			if len(secondShortcut) == 0 {
+				continue
+			}
+			switch {
+			case firstShortcut.Equal(secondShortcut) && !shortcutScopesOverlap(first.scope, second.scope):
+			case secondShortcut.hasPrefix(firstShortcut):
+				conflicts = append(conflicts, ShortcutConflict{
+					Actions:  [2]string{first.action, second.action},
+					Shortcut: firstShortcut,
+				})
+			case firstShortcut.hasPrefix(secondShortcut):
+				conflicts = append(conflicts, ShortcutConflict{
+					Actions:  [2]string{second.action, first.action},
+					Shortcut: secondShortcut,
+				})
+			}
+		}
+	}
+	return conflicts
+}
+
+// ConflictsOf returns the actions that are in conflict with given action.
+func (manager *ShortcutManager) ConflictsOf(action string) []string {
+	var actions []string
+	for _, conflict := range manager.Conflicts() {
+		if conflict.Actions[0] == action {
+			actions = append(actions, conflict.Actions[1])
+		} else if conflict.Actions[1] == action {
+			actions = append(actions, conflict.Actions[0])
+		}
+	}
+	return actions
+}
+
+// currentModifiers returns the modifier keys that are currently held down.
+func currentModifiers() KeyModifiers {
+	io := CurrentIO()
+	modifiers := KeyModifiersNone
+	if io.KeyCtrlPressed() {
+		modifiers |= KeyModifierCtrl
+	}
+	if io.KeyShiftPressed() {
+		modifiers |= KeyModifierShift
+	}
+	if io.KeyAltPressed() {
+		modifiers |= KeyModifierAlt
+	}
+	if io.KeySuperPressed() {
+		modifiers |= KeyModifierSuper
+	}
+	return modifiers
+}
+
+// pressedChord returns the chord of a key that was pressed during this frame.
+func (manager *ShortcutManager) pressedChord() (KeyChord, bool) {
+	names := make([]string, 0, len(manager.Keys)+len(shortcutNamedKeys))
+	for name := range manager.Keys {
+		names = append(names, name)
+	}
+	for name := range shortcutNamedKeys {
+		if _, known := manager.Keys[name]; !known {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if key, known := manager.nativeKey(name); known && IsKeyPressedV(key, false) {
+			return KeyChord{Modifiers: currentModifiers(), Key: name}, true
+		}
+	}
+	return KeyChord{}, false
+}
+
+// Update processes the keys pressed during this frame. Call it once per frame, after NewFrame().
+func (manager *ShortcutManager) Update() {
+	manager.triggered = nil
+	chord, pressed := manager.pressedChord()
+	if !pressed {
+		return
+	}
+	if manager.recording != "" {
+		manager.record(chord)
+		return
+	}
+	typing := CurrentIO().WantTextInput() && (chord.Modifiers&^KeyModifierShift == KeyModifiersNone)
+	if typing && (len(manager.pending) == 0) {
+		return
+	}
+
+	continued := append(manager.pending[:len(manager.pending):len(manager.pending)], chord)
+	for _, sequence := range []Shortcut{continued, {chord}} {
+		isPrefix, isComplete := false, false
+		for _, binding := range manager.bindings {
+			shortcut := manager.Shortcut(binding.action)
+			if shortcut.Equal(sequence) {
+				isComplete = true
+			} else if shortcut.hasPrefix(sequence) {
+				isPrefix = true
+			}
+		}
+		switch {
+		case isPrefix:
+			manager.pending = sequence
+			return
+		case isComplete:
+			manager.pending = nil
+			manager.triggered = sequence
+			return
+		case len(manager.pending) == 0:
+			return
+		}
+		manager.pending = nil
+	}
+}
+
+// Pending returns the chords entered so far of an incomplete chord sequence, or an empty shortcut.
+// It can be displayed to show that more chords are expected, such as "Ctrl+K was pressed. Waiting for second key".
+func (manager *ShortcutManager) Pending() Shortcut {
+	return append(Shortcut(nil), manager.pending...)
+}
+
+// Pressed returns true if the shortcut of given action was completed during this frame.
+// For ShortcutScopeWindow, call it between Begin() and End() of the window;
+// for ShortcutScopeItem, call it right after the item.
+func (manager *ShortcutManager) Pressed(action string) bool {
+	binding := manager.binding(action)
+	if (binding == nil) || (len(manager.triggered) == 0) || !manager.Shortcut(action).Equal(manager.triggered) {
+		return false
+	}
+	switch binding.scope {
+	case ShortcutScopeWindow:
+		return IsWindowFocusedV(FocusedFlagsRootAndChildWindows)
+	case ShortcutScopeItem:
+		return IsItemFocused()
+	default:
+		return true
+	}
+}
+
+// Save writes the rebindings as JSON object, mapping actions to shortcuts. Actions with their default shortcut
+// are not included, so that changed defaults apply after loading.
+func (manager *ShortcutManager) Save(writer io.Writer) error {
+	texts := make(map[string]string, len(manager.overrides))
+	for action, shortcut := range manager.overrides {
+		texts[action] = shortcut.String()
+	}
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(texts)
+}
+
+// Load replaces the rebindings with the ones written by Save(). Rebindings of actions that are not registered
+// are kept, and apply once the action is registered. On error, the rebindings are not modified.
+func (manager *ShortcutManager) Load(reader io.Reader) error {
+	var texts map[string]string
+	if err := json.NewDecoder(reader).Decode(&texts); err != nil {
+		return err
+	}
+	overrides := make(map[string]Shortcut, len(texts))
+	for action, text := range texts {
+		shortcut, err := manager.parse(text)
+		if err != nil {
+			return fmt.Errorf("action %q: %v", action, err)
+		}
+		overrides[action] = shortcut
+	}
+	manager.overrides = overrides
+	return nil
+}
+
+func (manager *ShortcutManager) record(chord KeyChord) {
+	action := manager.recording
+	manager.recording = ""
+	if (chord.Key == "Escape") && (chord.Modifiers == KeyModifiersNone) {
+		return
+	}
+	_ = manager.Bind(action, chord.String())
+}
+
+// RenderEditor displays a table of all actions to rebind their shortcuts.
+// Shortcuts can be entered as text, or recorded by pressing the keys of a single chord after clicking "Record".
+// Conflicting shortcuts are highlighted.
+func (manager *ShortcutManager) RenderEditor() {
+	conflicts := make(map[string][]string)
+	for _, conflict := range manager.Conflicts() {
+		conflicts[conflict.Actions[0]] = append(conflicts[conflict.Actions[0]], conflict.Actions[1])
+		conflicts[conflict.Actions[1]] = append(conflicts[conflict.Actions[1]], conflict.Actions[0])
+	}
+	if !BeginTableV("##shortcuts", 3, TableFlagsRowBg|TableFlagsBordersInnerH|TableFlagsSizingStretchProp, Vec2{}, 0) {
+		return
+	}
+	TableSetupColumnV("Action", TableColumnFlagsWidthStretch, 2, 0)
+	TableSetupColumnV("Shortcut", TableColumnFlagsWidthStretch, 1, 0)
+	TableSetupColumnV("##buttons", TableColumnFlagsWidthFixed, 0, 0)
+	TableHeadersRow()
+	for _, binding := range manager.bindings {
+		PushID(binding.action)
+		TableNextRow()
+		TableNextColumn()
+		Text(binding.action)
+		TableNextColumn()
+		manager.renderShortcutInput(binding.action, conflicts[binding.action])
+		TableNextColumn()
+		recordLabel := "Record"
+		if manager.recording == binding.action {
+			recordLabel = "Press keys..."
+		}
+		if SmallButton(recordLabel + "##record") {
+			manager.recording = binding.action
+		}
+		if manager.IsRebound(binding.action) {
+			SameLine()
+			if SmallButton("Reset") {
+				manager.Reset(binding.action)
+			}
+		}
+		PopID()
+	}
+	EndTable()
+}
+
+func (manager *ShortcutManager) renderShortcutInput(action string, conflicts []string) {
+	text, editing := manager.edits[action]
+	if !editing {
+		text = manager.Text(action)
+	}
+	editErr := manager.editErrors[action]
+	highlighted := (editErr != nil) || (len(conflicts) > 0)
+	if highlighted {
+		PushStyleColor(StyleColorFrameBg, Vec4{X: 0.6, Y: 0.15, Z: 0.15, W: 1})
+	}
+	SetNextItemWidth(-1)
+	InputTextV("##shortcut", &text, InputTextFlagsAutoSelectAll, nil)
+	if highlighted {
+		PopStyleColor()
+		if IsItemHovered() {
+			if editErr != nil {
+				SetTooltip(editErr.Error())
+			} else {
+				SetTooltip("Conflicts with " + strings.Join(conflicts, ", "))
+			}
+		}
+	}
+	if IsItemActive() {
+		manager.edits[action] = text
+		_, manager.editErrors[action] = manager.parse(text)
+	} else if editing {
+		delete(manager.edits, action)
+		delete(manager.editErrors, action)
+		_ = manager.Bind(action, text)
+	}
+}
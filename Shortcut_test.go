@@ -0,0 +1,151 @@
+package imgui_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ianling/imgui-go"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseShortcut(t *testing.T) {
+	tt := []struct {
+		text     string
+		expected string
+	}{
+		{text: "Ctrl+Shift+S", expected: "Ctrl+Shift+S"},
+		{text: "shift+ctrl+s", expected: "Ctrl+Shift+S"},
+		{text: "Ctrl+K  Ctrl+C", expected: "Ctrl+K Ctrl+C"},
+		{text: "cmd+option+esc", expected: "Alt+Super+Escape"},
+		{text: "Ctrl++", expected: "Ctrl++"},
+		{text: "f5", expected: "F5"},
+		{text: "Alt+pageup", expected: "Alt+PageUp"},
+		{text: "", expected: ""},
+	}
+	for _, tc := range tt {
+		td := tc
+		t.Run(td.text, func(t *testing.T) {
+			shortcut, err := imgui.ParseShortcut(td.text)
+			require.Nil(t, err)
+			assert.Equal(t, td.expected, shortcut.String())
+		})
+	}
+
+	for _, text := range []string{"Ctrl+", "Ctrl+Shift", "Hyper+S"} {
+		_, err := imgui.ParseShortcut(text)
+		assert.NotNil(t, err, "Shortcut %q should be invalid", text)
+	}
+}
+
+const (
+	shortcutTestKeyCtrl = 300
+	shortcutTestKeyF1   = 301
+)
+
+// renderShortcutFrame presses the given native keys for one frame, releases them in the next,
+// and returns the actions pressed in the first frame.
+func renderShortcutFrame(shortcuts *imgui.ShortcutManager, keys ...int) []string {
+	io := imgui.CurrentIO()
+	for _, key := range keys {
+		io.KeyPress(key)
+	}
+	io.KeyCtrl(shortcutTestKeyCtrl, shortcutTestKeyCtrl)
+	var pressed []string
+	renderTestFrame(func() {
+		shortcuts.Update()
+		testWindow(func() {
+			for _, action := range shortcuts.Actions() {
+				if shortcuts.Pressed(action) {
+					pressed = append(pressed, action)
+				}
+			}
+		})
+	})
+	for _, key := range keys {
+		if key != shortcutTestKeyCtrl {
+			io.KeyRelease(key)
+		}
+	}
+	renderTestFrame(shortcuts.Update)
+	return pressed
+}
+
+func TestShortcutManagerChords(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 800, Y: 600})
+	defer context.Destroy()
+	io := imgui.CurrentIO()
+
+	shortcuts := imgui.NewShortcutManager()
+	shortcuts.Keys["F1"] = shortcutTestKeyF1
+	require.Nil(t, shortcuts.Register("Save", "Ctrl+S", imgui.ShortcutScopeGlobal))
+	require.Nil(t, shortcuts.Register("Comment", "Ctrl+K Ctrl+C", imgui.ShortcutScopeWindow))
+	require.Nil(t, shortcuts.Register("Help", "F1", imgui.ShortcutScopeGlobal))
+	assert.NotNil(t, shortcuts.Register("Unknown", "F13", imgui.ShortcutScopeGlobal))
+	assert.Nil(t, shortcuts.Register("Zoom In", "Ctrl+=", imgui.ShortcutScopeGlobal))
+	for _, text := range []string{"Ctrl+!", "Ctrl+@", "Ctrl+Shift+_"} {
+		assert.NotNil(t, shortcuts.Register("Shifted", text, imgui.ShortcutScopeGlobal),
+			"Characters typed with Shift should not be keys: %s", text)
+	}
+	renderShortcutFrame(shortcuts)
+
+	assert.Equal(t, []string{"Help"}, renderShortcutFrame(shortcuts, shortcutTestKeyF1))
+	assert.Empty(t, renderShortcutFrame(shortcuts, 'S'), "Modifier should be required")
+	assert.Equal(t, []string{"Save"}, renderShortcutFrame(shortcuts, shortcutTestKeyCtrl, 'S'))
+
+	assert.Empty(t, renderShortcutFrame(shortcuts, 'K'))
+	assert.Equal(t, "Ctrl+K", shortcuts.Pending().String())
+	assert.Equal(t, []string{"Comment"}, renderShortcutFrame(shortcuts, 'C'))
+	assert.Empty(t, shortcuts.Pending())
+
+	renderShortcutFrame(shortcuts, 'K')
+	assert.Equal(t, []string{"Save"}, renderShortcutFrame(shortcuts, 'S'), "Broken sequence should start over")
+
+	require.Nil(t, shortcuts.Bind("Save", "Ctrl+Shift+S"))
+	io.KeyRelease(shortcutTestKeyCtrl)
+	assert.Empty(t, renderShortcutFrame(shortcuts, shortcutTestKeyCtrl, 'S'), "Rebound shortcut should replace default")
+	assert.Equal(t, "Ctrl+Shift+S", shortcuts.Text("Save"))
+
+	renderTestWindow(shortcuts.RenderEditor)
+}
+
+func TestShortcutManagerConflicts(t *testing.T) {
+	shortcuts := imgui.NewShortcutManager()
+	require.Nil(t, shortcuts.Register("Save", "Ctrl+S", imgui.ShortcutScopeGlobal))
+	require.Nil(t, shortcuts.Register("Search", "Ctrl+F", imgui.ShortcutScopeWindow))
+	require.Nil(t, shortcuts.Register("Find in Editor", "Ctrl+F", imgui.ShortcutScopeItem))
+	require.Nil(t, shortcuts.Register("Comment", "Ctrl+K Ctrl+C", imgui.ShortcutScopeWindow))
+	assert.Empty(t, shortcuts.Conflicts(), "Same shortcut in different scopes should not conflict")
+
+	require.Nil(t, shortcuts.Bind("Save", "Ctrl+F"))
+	assert.Equal(t, []string{"Search", "Find in Editor"}, shortcuts.ConflictsOf("Save"))
+
+	require.Nil(t, shortcuts.Bind("Save", "Ctrl+K"))
+	conflicts := shortcuts.Conflicts()
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, [2]string{"Save", "Comment"}, conflicts[0].Actions, "Prefix of a sequence should conflict")
+	assert.Equal(t, "Ctrl+K", conflicts[0].Shortcut.String())
+}
+
+func TestShortcutManagerSaveLoad(t *testing.T) {
+	shortcuts := imgui.NewShortcutManager()
+	require.Nil(t, shortcuts.Register("Save", "Ctrl+S", imgui.ShortcutScopeGlobal))
+	require.Nil(t, shortcuts.Register("Open", "Ctrl+O", imgui.ShortcutScopeGlobal))
+	require.Nil(t, shortcuts.Bind("Save", "Alt+S"))
+	require.Nil(t, shortcuts.Bind("Open", "Ctrl+O"))
+	assert.False(t, shortcuts.IsRebound("Open"), "Binding the default should not be a rebinding")
+
+	var buf bytes.Buffer
+	require.Nil(t, shortcuts.Save(&buf))
+
+	loaded := imgui.NewShortcutManager()
+	require.Nil(t, loaded.Load(bytes.NewReader(buf.Bytes())))
+	require.Nil(t, loaded.Register("Save", "Ctrl+S", imgui.ShortcutScopeGlobal))
+	assert.Equal(t, "Alt+S", loaded.Text("Save"), "Loaded rebinding should apply to later registration")
+	loaded.Reset("Save")
+	assert.Equal(t, "Ctrl+S", loaded.Text("Save"))
+
+	assert.NotNil(t, loaded.Load(bytes.NewReader([]byte(`{"Save": "Ctrl+"}`))))
+	assert.NotNil(t, loaded.Load(bytes.NewReader([]byte(`[`))))
+}
@@ -163,6 +163,12 @@ func IsWindowHovered() bool {
 	return IsWindowHoveredV(HoveredFlagsNone)
 }
 
+// KeyIndex maps an imgui key, such as KeyUpArrow, to the native key index registered with IO.KeyMap().
+// The returned index can be passed to IsKeyDown(), IsKeyPressed() and IsKeyReleased().
+func KeyIndex(key int) int {
+	return int(C.iggGetKeyIndex(C.int(key)))
+}
+
 // IsKeyDown returns true if the corresponding key is currently being held down.
 func IsKeyDown(key int) bool {
 	return C.iggIsKeyDown(C.int(key)) != 0
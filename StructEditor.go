@@ -0,0 +1,546 @@
+package imgui
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// EditableEnum is implemented by types that EditStruct() edits with a combo, such as enumerations of constants.
+// EnumValues returns all values of the type, which are displayed with fmt.Sprint(), and thus their String() method.
+type EditableEnum interface {
+	EnumValues() []interface{}
+}
+
+// structFieldTag holds the options of the "imgui" tag of a struct field.
+type structFieldTag struct {
+	skip      bool
+	label     string
+	min       string
+	max       string
+	speed     float32
+	format    string
+	slider    bool
+	color     bool
+	multiline bool
+	enum      []string
+}
+
+func parseStructFieldTag(tag string) (structFieldTag, error) {
+	parsed := structFieldTag{speed: 1}
+	if tag == "-" {
+		parsed.skip = true
+		return parsed, nil
+	}
+	for _, option := range strings.Split(tag, ",") {
+		option = strings.TrimSpace(option)
+		key, value := option, ""
+		if equals := strings.IndexByte(option, '='); equals >= 0 {
+			key, value = option[:equals], option[equals+1:]
+		}
+		switch key {
+		case "":
+		case "label":
+			parsed.label = value
+		case "min":
+			parsed.min = value
+		case "max":
+			parsed.max = value
+		case "speed":
+			speed, err := strconv.ParseFloat(value, 32)
+			if err != nil {
+				return parsed, fmt.Errorf("invalid speed %q", value)
+			}
+			parsed.speed = float32(speed)
+		case "format":
+			parsed.format = value
+		case "slider":
+			parsed.slider = true
+		case "color":
+			parsed.color = true
+		case "multiline":
+			parsed.multiline = true
+		case "enum":
+			parsed.enum = strings.Split(value, "|")
+		default:
+			return parsed, fmt.Errorf("unknown option %q", key)
+		}
+	}
+	if parsed.slider && ((parsed.min == "") || (parsed.max == "")) {
+		return parsed, errors.New("slider requires min and max")
+	}
+	return parsed, nil
+}
+
+// EditStruct calls EditStructV(label, v, nil).
+func EditStruct(label string, v interface{}) bool {
+	return EditStructV(label, v, nil)
+}
+
+// EditStructV renders an editor for the exported fields of the struct that v points to, within a tree node of
+// given label. The fields are laid out without tree node if the label is empty.
+// It returns true if a value was changed in this frame.
+//
+// Fields are edited according to their type:
+// bool with Checkbox(); numbers with DragScalarNV(), or SliderScalarNV(); strings with InputText();
+// color.RGBA, color.NRGBA and PackedColor with ColorEdit4(); Vec2 and Vec4 with DragFloat2V() and DragFloat4V();
+// types that implement EditableEnum with a combo. Structs, arrays, slices and maps are edited within tree nodes,
+// pointers and interfaces are edited by the value they refer to.
+//
+// The optional "imgui" tag of a field holds comma separated options:
+//   label=Text  displays the field with given label, instead of its name.
+//   min=0       and max=10 limit numbers; speed=0.1 sets the speed of dragging. format=%.2f sets the display format.
+//   slider      edits a number with a slider, which requires min and max.
+//   color       edits a Vec4, [3]float32 or [4]float32 with a color editor.
+//   multiline   edits a string with a multiline text input.
+//   enum=A|B|C  edits an integer with a combo of given names for the values 0, 1, 2..., or a string with given values.
+//   -           skips the field.
+//
+// edited, if not nil, is called with the path of a field once an edit of it was completed,
+// with the semantics of IsItemDeactivatedAfterEdit(). Paths are formed like "Light.Colors[2]" or "Limits[key]".
+//
+// Usage:
+//   type Settings struct {
+//       Volume  float32 `imgui:"min=0,max=1,speed=0.01"`
+//       Quality int     `imgui:"enum=Low|Medium|High"`
+//   }
+//   ...
+//   imgui.EditStructV("Settings", &settings, func(path string) { log.Printf("%s changed", path) })
+func EditStructV(label string, v interface{}, edited func(path string)) bool {
+	value := reflect.ValueOf(v)
+	editor := structEditor{edited: edited}
+	PushID(label)
+	defer PopID()
+	if (value.Kind() != reflect.Ptr) || value.IsNil() || (value.Elem().Kind() != reflect.Struct) {
+		editor.showError(label, fmt.Errorf("%T is no pointer to a struct", v))
+		return false
+	}
+	if label == "" {
+		editor.editFields(value.Elem(), "")
+		return editor.changed
+	}
+	if TreeNodeV(label, TreeNodeFlagsDefaultOpen) {
+		editor.editFields(value.Elem(), "")
+		TreePop()
+	}
+	return editor.changed
+}
+
+type structEditor struct {
+	edited  func(path string)
+	changed bool
+}
+
+var (
+	vec2Type = reflect.TypeOf(Vec2{})
+	vec4Type = reflect.TypeOf(Vec4{})
+
+	// structEditorColorModels are the models of the color types that are edited with a color editor.
+	structEditorColorModels = map[reflect.Type]color.Model{
+		reflect.TypeOf(color.RGBA{}):   color.RGBAModel,
+		reflect.TypeOf(color.NRGBA{}):  color.NRGBAModel,
+		reflect.TypeOf(PackedColor(0)): PackedColorModel,
+	}
+)
+
+// finish reports the edit of the last item, and returns the changed state.
+func (editor *structEditor) finish(path string, changed bool) bool {
+	if (IsItemDeactivatedAfterEdit() || (changed && !IsItemActive())) && (editor.edited != nil) {
+		editor.edited(path)
+	}
+	if changed {
+		editor.changed = true
+	}
+	return changed
+}
+
+func (editor *structEditor) showError(label string, err error) {
+	PushStyleColor(StyleColorText, Vec4{X: 1, Y: 0.4, Z: 0.4, W: 1})
+	Text(label + ": " + err.Error())
+	PopStyleColor()
+}
+
+func (editor *structEditor) editFields(value reflect.Value, path string) {
+	valueType := value.Type()
+	for index := 0; index < valueType.NumField(); index++ {
+		field := valueType.Field(index)
+		if field.PkgPath != "" {
+			continue
+		}
+		tag, err := parseStructFieldTag(field.Tag.Get("imgui"))
+		if tag.skip {
+			continue
+		}
+		PushID(field.Name)
+		label := field.Name
+		if tag.label != "" {
+			label = tag.label
+		}
+		switch {
+		case err != nil:
+			editor.showError(label, err)
+		case field.Anonymous && (field.Type.Kind() == reflect.Struct):
+			// Fields of embedded structs are promoted, as in Go.
+			editor.editFields(value.Field(index), path)
+		default:
+			editor.edit(label, joinStructPath(path, field.Name), value.Field(index), tag)
+		}
+		PopID()
+	}
+}
+
+func joinStructPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// edit renders the editor for an addressable value, returning true if it was changed.
+func (editor *structEditor) edit(label string, path string, value reflect.Value, tag structFieldTag) bool {
+	if model, isColor := structEditorColorModels[value.Type()]; isColor {
+		return editor.editColor(label, path, value, model)
+	}
+	isReference := (value.Kind() == reflect.Ptr) || (value.Kind() == reflect.Interface)
+	if _, isEnum := value.Interface().(EditableEnum); isEnum && !isReference {
+		return editor.editEnum(label, path, value)
+	}
+	switch value.Type() {
+	case vec2Type:
+		vec := value.Addr().Interface().(*Vec2)
+		values := [2]float32{vec.X, vec.Y}
+		changed := DragFloat2V(label, &values, tag.speed, 0, 0, structFieldFormat(tag.format, "%.3f"), SliderFlagsNone)
+		*vec = Vec2{X: values[0], Y: values[1]}
+		return editor.finish(path, changed)
+	case vec4Type:
+		vec := value.Addr().Interface().(*Vec4)
+		values := [4]float32{vec.X, vec.Y, vec.Z, vec.W}
+		var changed bool
+		if tag.color {
+			changed = ColorEdit4(label, &values)
+		} else {
+			changed = DragFloat4V(label, &values, tag.speed, 0, 0, structFieldFormat(tag.format, "%.3f"), SliderFlagsNone)
+		}
+		*vec = Vec4{X: values[0], Y: values[1], Z: values[2], W: values[3]}
+		return editor.finish(path, changed)
+	}
+
+	switch value.Kind() {
+	case reflect.Bool:
+		checked := value.Bool()
+		changed := Checkbox(label, &checked)
+		value.SetBool(checked)
+		return editor.finish(path, changed)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		if (len(tag.enum) > 0) && (value.Kind() != reflect.Float32) && (value.Kind() != reflect.Float64) {
+			return editor.editIndexEnum(label, path, value, tag.enum)
+		}
+		return editor.editNumber(label, path, value, tag)
+	case reflect.String:
+		return editor.editString(label, path, value, tag)
+	case reflect.Array:
+		if tag.color && (value.Type().Elem().Kind() == reflect.Float32) && ((value.Len() == 3) || (value.Len() == 4)) {
+			return editor.editColorArray(label, path, value)
+		}
+		return editor.editElements(label, path, value)
+	case reflect.Slice:
+		return editor.editElements(label, path, value)
+	case reflect.Struct:
+		if !TreeNode(label) {
+			return false
+		}
+		changedBefore := editor.changed
+		editor.changed = false
+		editor.editFields(value, path)
+		changed := editor.changed
+		editor.changed = changedBefore || changed
+		TreePop()
+		return changed
+	case reflect.Map:
+		return editor.editMap(label, path, value)
+	case reflect.Ptr:
+		if value.IsNil() {
+			LabelText(label, "nil")
+			return false
+		}
+		return editor.edit(label, path, value.Elem(), tag)
+	case reflect.Interface:
+		if value.IsNil() {
+			LabelText(label, "nil")
+			return false
+		}
+		return editor.editCopy(label, path, value.Elem(), tag, value.Set)
+	default:
+		LabelText(label, value.Type().String())
+		return false
+	}
+}
+
+// editCopy edits a value that is not addressable, such as the content of an interface or map, in a copy.
+// The copy is stored with set if it was changed.
+func (editor *structEditor) editCopy(label string, path string, value reflect.Value, tag structFieldTag,
+	set func(reflect.Value)) bool {
+	if value.Kind() == reflect.Ptr {
+		return editor.edit(label, path, value, tag)
+	}
+	copied := reflect.New(value.Type()).Elem()
+	copied.Set(value)
+	changed := editor.edit(label, path, copied, tag)
+	if changed {
+		set(copied)
+	}
+	return changed
+}
+
+func structFieldFormat(format, fallback string) string {
+	if format == "" {
+		return fallback
+	}
+	return format
+}
+
+func structFieldDataType(value reflect.Value) DataType {
+	size := value.Type().Size()
+	switch value.Kind() {
+	case reflect.Int8:
+		return DataTypeInt8
+	case reflect.Int16:
+		return DataTypeInt16
+	case reflect.Int32:
+		return DataTypeInt32
+	case reflect.Int, reflect.Int64:
+		if size == 4 {
+			return DataTypeInt32
+		}
+		return DataTypeInt64
+	case reflect.Uint8:
+		return DataTypeUint8
+	case reflect.Uint16:
+		return DataTypeUint16
+	case reflect.Uint32:
+		return DataTypeUint32
+	case reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		if size == 4 {
+			return DataTypeUint32
+		}
+		return DataTypeUint64
+	case reflect.Float32:
+		return DataTypeFloat32
+	default:
+		return DataTypeFloat64
+	}
+}
+
+// newStructFieldLimit returns a pointer to a new value of the type of the field, parsed from given text.
+// It returns nil for an empty text.
+func newStructFieldLimit(valueType reflect.Type, text string) (unsafe.Pointer, error) {
+	if text == "" {
+		return nil, nil
+	}
+	limit := reflect.New(valueType)
+	switch valueType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number, err := strconv.ParseInt(text, 0, valueType.Bits())
+		if err != nil {
+			return nil, fmt.Errorf("invalid limit %q", text)
+		}
+		limit.Elem().SetInt(number)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		number, err := strconv.ParseUint(text, 0, valueType.Bits())
+		if err != nil {
+			return nil, fmt.Errorf("invalid limit %q", text)
+		}
+		limit.Elem().SetUint(number)
+	default:
+		number, err := strconv.ParseFloat(text, valueType.Bits())
+		if err != nil {
+			return nil, fmt.Errorf("invalid limit %q", text)
+		}
+		limit.Elem().SetFloat(number)
+	}
+	return unsafe.Pointer(limit.Pointer()), nil
+}
+
+func (editor *structEditor) editNumber(label string, path string, value reflect.Value, tag structFieldTag) bool {
+	min, err := newStructFieldLimit(value.Type(), tag.min)
+	if err == nil {
+		var max unsafe.Pointer
+		max, err = newStructFieldLimit(value.Type(), tag.max)
+		if err == nil {
+			dataType := structFieldDataType(value)
+			// The value is edited in a copy, as the struct containing it may hold Go pointers, which cgo rejects.
+			copied := reflect.New(value.Type())
+			copied.Elem().Set(value)
+			data := unsafe.Pointer(copied.Pointer())
+			var changed bool
+			if tag.slider {
+				changed = SliderScalarNV(label, dataType, data, 1, min, max, tag.format, SliderFlagsAlwaysClamp)
+			} else {
+				changed = DragScalarNV(label, dataType, data, 1, tag.speed, min, max, tag.format, SliderFlagsAlwaysClamp)
+			}
+			value.Set(copied.Elem())
+			return editor.finish(path, changed)
+		}
+	}
+	editor.showError(label, err)
+	return false
+}
+
+func (editor *structEditor) editString(label string, path string, value reflect.Value, tag structFieldTag) bool {
+	if len(tag.enum) > 0 {
+		current := value.String()
+		changed := false
+		if BeginCombo(label, current) {
+			for index, name := range tag.enum {
+				if SelectableV(name+"##"+strconv.Itoa(index), name == current, 0, Vec2{}) {
+					value.SetString(name)
+					changed = true
+				}
+			}
+			EndCombo()
+		}
+		return editor.finish(path, changed)
+	}
+	text := value.String()
+	var changed bool
+	if tag.multiline {
+		changed = InputTextMultiline(label, &text)
+	} else {
+		changed = InputText(label, &text)
+	}
+	value.SetString(text)
+	return editor.finish(path, changed)
+}
+
+// editIndexEnum edits an integer as index of given names.
+func (editor *structEditor) editIndexEnum(label string, path string, value reflect.Value, names []string) bool {
+	var current int64
+	if (value.Kind() >= reflect.Uint) && (value.Kind() <= reflect.Uintptr) {
+		current = int64(value.Uint())
+	} else {
+		current = value.Int()
+	}
+	preview := strconv.FormatInt(current, 10)
+	if (current >= 0) && (current < int64(len(names))) {
+		preview = names[current]
+	}
+	changed := false
+	if BeginCombo(label, preview) {
+		for index, name := range names {
+			if SelectableV(name+"##"+strconv.Itoa(index), int64(index) == current, 0, Vec2{}) {
+				if (value.Kind() >= reflect.Uint) && (value.Kind() <= reflect.Uintptr) {
+					value.SetUint(uint64(index))
+				} else {
+					value.SetInt(int64(index))
+				}
+				changed = true
+			}
+		}
+		EndCombo()
+	}
+	return editor.finish(path, changed)
+}
+
+func (editor *structEditor) editEnum(label string, path string, value reflect.Value) bool {
+	current := value.Interface()
+	changed := false
+	if BeginCombo(label, fmt.Sprint(current)) {
+		for index, option := range current.(EditableEnum).EnumValues() {
+			optionValue := reflect.ValueOf(option)
+			if !optionValue.IsValid() || !optionValue.Type().ConvertibleTo(value.Type()) {
+				continue
+			}
+			selected := reflect.DeepEqual(option, current)
+			if SelectableV(fmt.Sprint(option)+"##"+strconv.Itoa(index), selected, 0, Vec2{}) {
+				value.Set(optionValue.Convert(value.Type()))
+				changed = true
+			}
+		}
+		EndCombo()
+	}
+	return editor.finish(path, changed)
+}
+
+func (editor *structEditor) editColor(label string, path string, value reflect.Value, model color.Model) bool {
+	current := color.NRGBAModel.Convert(value.Interface().(color.Color)).(color.NRGBA)
+	values := [4]float32{
+		float32(current.R) / 0xFF, float32(current.G) / 0xFF, float32(current.B) / 0xFF, float32(current.A) / 0xFF,
+	}
+	changed := ColorEdit4(label, &values)
+	if changed {
+		edited := PackedColorFromVec4(Vec4{X: values[0], Y: values[1], Z: values[2], W: values[3]})
+		value.Set(reflect.ValueOf(model.Convert(edited)))
+	}
+	return editor.finish(path, changed)
+}
+
+func (editor *structEditor) editColorArray(label string, path string, value reflect.Value) bool {
+	var values [4]float32
+	for index := 0; index < value.Len(); index++ {
+		values[index] = float32(value.Index(index).Float())
+	}
+	var changed bool
+	if value.Len() == 3 {
+		rgb := [3]float32{values[0], values[1], values[2]}
+		changed = ColorEdit3(label, &rgb)
+		copy(values[:], rgb[:])
+	} else {
+		changed = ColorEdit4(label, &values)
+	}
+	for index := 0; index < value.Len(); index++ {
+		value.Index(index).SetFloat(float64(values[index]))
+	}
+	return editor.finish(path, changed)
+}
+
+// editElements edits the elements of an array or slice within a tree node.
+func (editor *structEditor) editElements(label string, path string, value reflect.Value) bool {
+	if !TreeNode(label + " [" + strconv.Itoa(value.Len()) + "]###" + label) {
+		return false
+	}
+	changed := false
+	for index := 0; index < value.Len(); index++ {
+		element := "[" + strconv.Itoa(index) + "]"
+		PushIDInt(index)
+		changed = editor.edit(element, path+element, value.Index(index), structFieldTag{speed: 1}) || changed
+		PopID()
+	}
+	TreePop()
+	return changed
+}
+
+// editMap edits the values of a map within a tree node, sorted by their keys.
+func (editor *structEditor) editMap(label string, path string, value reflect.Value) bool {
+	if !TreeNode(label + " [" + strconv.Itoa(value.Len()) + "]###" + label) {
+		return false
+	}
+	keys := value.MapKeys()
+	names := make([]string, len(keys))
+	for index, key := range keys {
+		names[index] = fmt.Sprint(key.Interface())
+	}
+	order := make([]int, len(keys))
+	for index := range order {
+		order[index] = index
+	}
+	sort.SliceStable(order, func(a, b int) bool { return names[order[a]] < names[order[b]] })
+
+	changed := false
+	for _, index := range order {
+		key := keys[index]
+		element := "[" + names[index] + "]"
+		PushID(names[index])
+		changed = editor.editCopy(element, path+element, value.MapIndex(key), structFieldTag{speed: 1},
+			func(edited reflect.Value) { value.SetMapIndex(key, edited) }) || changed
+		PopID()
+	}
+	TreePop()
+	return changed
+}
@@ -0,0 +1,81 @@
+package imgui_test
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/ianling/imgui-go"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type editedQuality int
+
+func (editedQuality) EnumValues() []interface{} {
+	return []interface{}{editedQuality(0), editedQuality(1)}
+}
+
+type editedInner struct {
+	Name  string
+	Color color.RGBA
+}
+
+type editedEmbedded struct {
+	Embedded float64 `imgui:"slider,min=-1,max=1"`
+}
+
+type editedSettings struct {
+	Enabled bool
+	editedEmbedded
+	Count    int     `imgui:"min=0,max=10"`
+	Volume   float32 `imgui:"speed=0.01,format=%.2f"`
+	Small    uint8   `imgui:"enum=Off|On"`
+	Mode     string  `imgui:"enum=A|B"`
+	Notes    string  `imgui:"multiline,label=Remarks"`
+	Quality  editedQuality
+	Tint     imgui.Vec4 `imgui:"color"`
+	Rgb      [3]float32 `imgui:"color"`
+	Packed   imgui.PackedColor
+	Position imgui.Vec2
+	Inner    editedInner
+	Pointer  *editedInner
+	Items    []int
+	Limits   map[string]float32
+	Any      interface{}
+	Invalid  int    `imgui:"slider"`
+	Ignored  func() `imgui:"-"`
+	hidden   int
+}
+
+func TestEditStruct(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 800, Y: 2000})
+	defer context.Destroy()
+	io := imgui.CurrentIO()
+
+	settings := editedSettings{
+		Inner:   editedInner{Name: "inner"},
+		Pointer: &editedInner{Name: "pointer"},
+		Items:   []int{1, 2, 3},
+		Limits:  map[string]float32{"b": 2, "a": 1},
+		Any:     "text",
+		hidden:  1,
+	}
+	var paths []string
+	var changedFrames []int
+	io.SetMousePosition(imgui.Vec2{X: 16, Y: 16})
+	for frame := 0; frame < 4; frame++ {
+		io.SetMouseButtonDown(0, frame == 1)
+		renderTestWindow(func() {
+			if imgui.EditStructV("", &settings, func(path string) { paths = append(paths, path) }) {
+				changedFrames = append(changedFrames, frame)
+			}
+			imgui.EditStruct("Tree", &settings.Inner)
+			assert.False(t, imgui.EditStruct("Invalid", settings), "Non-pointer should not be edited")
+		})
+	}
+
+	assert.True(t, settings.Enabled, "Checkbox should have been toggled")
+	assert.Equal(t, []int{2}, changedFrames)
+	assert.Equal(t, []string{"Enabled"}, paths)
+	assert.Equal(t, 1, settings.hidden)
+}
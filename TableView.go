@@ -0,0 +1,330 @@
+package imgui
+
+import (
+	"sort"
+	"strings"
+)
+
+// TableDataSource provides the rows of a TableView.
+// Rows are identified by their index in the data source, from 0 to RowCount()-1.
+type TableDataSource interface {
+	// RowCount returns the number of rows.
+	RowCount() int
+	// CellText returns the text of the cell in given row and column.
+	// It is displayed unless the data source implements TableCellRenderer,
+	// and it is compared for sorting columns without a Compare function.
+	CellText(row, column int) string
+}
+
+// TableCellRenderer can be implemented by a TableDataSource to render cells with arbitrary widgets.
+type TableCellRenderer interface {
+	// RenderCell submits the contents of the cell in given row and column.
+	// It is called with the table cell being current.
+	RenderCell(row, column int)
+}
+
+// TableViewColumn describes one column of a TableView.
+type TableViewColumn struct {
+	// Label is displayed in the header row.
+	Label string
+	// Flags are passed on to TableSetupColumnV().
+	Flags TableColumnFlags
+	// InitWidthOrWeight is passed on to TableSetupColumnV().
+	InitWidthOrWeight float32
+	// Compare returns a negative value if row a sorts before row b, a positive value if it sorts after b,
+	// and zero if both are equal. If nil, the texts of the cells are compared.
+	Compare func(a, b int) int
+}
+
+// TableViewFlagsDefault are the TableFlags a new TableView is created with.
+const TableViewFlagsDefault = TableFlagsScrollY | TableFlagsRowBg | TableFlagsBordersOuter | TableFlagsBordersV |
+	TableFlagsResizable | TableFlagsReorderable | TableFlagsHideable | TableFlagsSortable | TableFlagsSortMulti
+
+// TableView is a virtualized table of the rows of a TableDataSource.
+//
+// It combines BeginTableV(), TableSetupScrollFreeze(), TableHeadersRow() and a ListClipper so that only rows
+// which are visible are requested from the data source. Columns are sorted according to the TableSortSpecs the user
+// selects in the header row, with multiple columns if TableFlagsSortMulti is set.
+// Sorting is the only operation that touches all rows, and it is only done when the sort specs or the row count change.
+//
+// Usage:
+//   view := imgui.NewTableView("files", []imgui.TableViewColumn{{Label: "Name"}, {Label: "Size", Compare: bySize}})
+//   ...
+//   view.Render(source)
+type TableView struct {
+	// ID is the identifier of the table.
+	ID string
+	// Columns describe the columns of the table.
+	Columns []TableViewColumn
+	// Flags are passed on to BeginTableV().
+	Flags TableFlags
+	// Size is the outer size of the table. A zero size uses all the available space.
+	Size Vec2
+	// FreezeColumns is the number of left-most columns that stay visible when scrolling horizontally.
+	FreezeColumns int
+	// Selectable enables selecting rows with mouse and keyboard, as described by SelectionModel.
+	Selectable bool
+
+	order        []int
+	positions    []int
+	sortSpecs    []TableColumnSortSpecs
+	sortRowCount int
+	sortInvalid  bool
+
+	selection       SelectionModel
+	columnsEnabled  []bool
+	columnsRequests map[int]bool
+}
+
+// NewTableView returns a table view for the given columns, using TableViewFlagsDefault.
+func NewTableView(id string, columns []TableViewColumn) *TableView {
+	return &TableView{
+		ID:         id,
+		Columns:    columns,
+		Flags:      TableViewFlagsDefault,
+		Selectable: true,
+	}
+}
+
+// Render submits the table for the given data source.
+// It returns true if the selection was changed by the user.
+func (view *TableView) Render(source TableDataSource) bool {
+	columnCount := len(view.Columns)
+	if columnCount == 0 {
+		return false
+	}
+	if !BeginTableV(view.ID, columnCount, view.Flags, view.Size, 0) {
+		return false
+	}
+	defer EndTable()
+
+	TableSetupScrollFreeze(view.FreezeColumns, 1)
+	for index, column := range view.Columns {
+		TableSetupColumnV(column.Label, column.Flags, column.InitWidthOrWeight, uint(index))
+	}
+	TableHeadersRow()
+
+	view.updateColumnsEnabled(columnCount)
+	rowCount := source.RowCount()
+	view.updateOrder(source, rowCount)
+
+	renderer, hasRenderer := source.(TableCellRenderer)
+	changed := false
+	firstRowPosY := CursorPosY()
+	var rowHeight float32
+	var clipper ListClipper
+	clipper.Begin(rowCount)
+	for clipper.Step() {
+		rowHeight = clipper.ItemsHeight
+		for displayIndex := clipper.DisplayStart; displayIndex < clipper.DisplayEnd; displayIndex++ {
+			row := view.RowAt(displayIndex)
+			TableNextRow()
+			PushIDInt(row)
+			selectableSubmitted := !view.Selectable
+			for column := 0; column < columnCount; column++ {
+				if !TableSetColumnIndex(column) {
+					continue
+				}
+				if !selectableSubmitted {
+					changed = view.rowSelectable(displayIndex) || changed
+					selectableSubmitted = true
+				}
+				if hasRenderer {
+					renderer.RenderCell(row, column)
+				} else {
+					Text(source.CellText(row, column))
+				}
+			}
+			PopID()
+		}
+	}
+	if view.Selectable {
+		changed = view.selection.HandleKeyboard(rowCount) || changed
+		view.selection.ScrollToFocus(firstRowPosY, rowHeight)
+	}
+	return changed
+}
+
+// rowSelectable submits a selectable spanning the whole row, leaving the cursor at the start of the cell.
+func (view *TableView) rowSelectable(displayIndex int) bool {
+	cellStart := CursorPos()
+	clicked := view.selection.SelectableV("##row", displayIndex,
+		SelectableFlagsSpanAllColumns|SelectableFlagsAllowItemOverlap, Vec2{})
+	SetCursorPos(cellStart)
+	return clicked
+}
+
+func (view *TableView) updateColumnsEnabled(columnCount int) {
+	if len(view.columnsEnabled) != columnCount {
+		view.columnsEnabled = make([]bool, columnCount)
+	}
+	for column := 0; column < columnCount; column++ {
+		if enabled, requested := view.columnsRequests[column]; requested {
+			TableSetColumnEnabled(column, enabled)
+		}
+		view.columnsEnabled[column] = (TableGetColumnFlagsV(column) & TableColumnFlagsIsEnabled) != 0
+	}
+	view.columnsRequests = nil
+}
+
+func (view *TableView) updateOrder(source TableDataSource, rowCount int) {
+	specs := TableGetSortSpecs()
+	if specs.SpecsDirty() {
+		view.sortSpecs = specs.Specs()
+		view.sortInvalid = true
+		specs.ClearSpecsDirty()
+	}
+	if (rowCount != view.sortRowCount) || view.sortInvalid {
+		view.sortRows(source, rowCount)
+	}
+}
+
+func (view *TableView) sortRows(source TableDataSource, rowCount int) {
+	selectedRows := view.Selection()
+	anchorRow, focusRow := view.rowAtOrNone(view.selection.Anchor()), view.rowAtOrNone(view.selection.Focus())
+
+	view.sortRowCount = rowCount
+	view.sortInvalid = false
+	if len(view.sortSpecs) == 0 {
+		view.order = nil
+		view.positions = nil
+	} else {
+		view.order = make([]int, rowCount)
+		for i := range view.order {
+			view.order[i] = i
+		}
+		sort.SliceStable(view.order, func(i, j int) bool {
+			return view.compareRows(source, view.order[i], view.order[j]) < 0
+		})
+		view.positions = make([]int, rowCount)
+		for displayIndex, row := range view.order {
+			view.positions[row] = displayIndex
+		}
+	}
+
+	// The selection is kept by display position, which changed with the new order.
+	selectedPositions := make([]int, 0, len(selectedRows))
+	for _, row := range selectedRows {
+		if row < rowCount {
+			selectedPositions = append(selectedPositions, view.positionOf(row))
+		}
+	}
+	sort.Ints(selectedPositions)
+	view.selection.setIndices(selectedPositions)
+	if (anchorRow >= 0) && (anchorRow < rowCount) {
+		view.selection.setAnchor(view.positionOf(anchorRow))
+		if (focusRow >= 0) && (focusRow < rowCount) {
+			view.selection.focus = view.positionOf(focusRow)
+		}
+	} else {
+		view.selection.hasFocus = false
+	}
+}
+
+func (view *TableView) rowAtOrNone(displayIndex int) int {
+	if (displayIndex < 0) || (displayIndex >= view.sortRowCount) {
+		return -1
+	}
+	return view.RowAt(displayIndex)
+}
+
+// positionOf returns the display index of given row of the data source.
+func (view *TableView) positionOf(row int) int {
+	if view.positions == nil {
+		return row
+	}
+	return view.positions[row]
+}
+
+func (view *TableView) compareRows(source TableDataSource, a, b int) int {
+	for _, spec := range view.sortSpecs {
+		column := int(spec.ColumnIndex)
+		var result int
+		if compare := view.Columns[column].Compare; compare != nil {
+			result = compare(a, b)
+		} else {
+			result = strings.Compare(source.CellText(a, column), source.CellText(b, column))
+		}
+		if spec.SortDirection == SortDirectionDescending {
+			result = -result
+		}
+		if result != 0 {
+			return result
+		}
+	}
+	return a - b
+}
+
+// InvalidateSort requests the rows to be sorted again during the next Render().
+// Call this when the data of the source changed without its row count changing.
+func (view *TableView) InvalidateSort() {
+	view.sortInvalid = true
+}
+
+// RowAt returns the row of the data source that is displayed at given position, considering the current sort order.
+func (view *TableView) RowAt(displayIndex int) int {
+	if view.order == nil {
+		return displayIndex
+	}
+	return view.order[displayIndex]
+}
+
+// SortSpecs returns the sort specifications the rows are currently sorted by.
+func (view *TableView) SortSpecs() []TableColumnSortSpecs {
+	return view.sortSpecs
+}
+
+// IsColumnEnabled returns whether the given column was shown during the last Render().
+// Columns can be hidden by the user through the context menu of the header row.
+func (view *TableView) IsColumnEnabled(column int) bool {
+	if (column < 0) || (column >= len(view.columnsEnabled)) {
+		return true
+	}
+	return view.columnsEnabled[column]
+}
+
+// SetColumnEnabled shows or hides the given column, starting with the next Render().
+func (view *TableView) SetColumnEnabled(column int, enabled bool) {
+	if view.columnsRequests == nil {
+		view.columnsRequests = make(map[int]bool)
+	}
+	view.columnsRequests[column] = enabled
+}
+
+// IsSelected returns whether the given row of the data source is selected.
+func (view *TableView) IsSelected(row int) bool {
+	if (row < 0) || (row >= view.sortRowCount) {
+		return false
+	}
+	return view.selection.IsSelected(view.positionOf(row))
+}
+
+// SetSelected changes the selection state of the given row of the data source.
+func (view *TableView) SetSelected(row int, selected bool) {
+	if (row < 0) || (row >= view.sortRowCount) {
+		return
+	}
+	view.selection.SetSelected(view.positionOf(row), selected)
+}
+
+// ClearSelection deselects all rows.
+func (view *TableView) ClearSelection() {
+	view.selection.Clear()
+}
+
+// Selection returns the selected rows of the data source, in ascending order.
+func (view *TableView) Selection() []int {
+	rows := view.selection.Indices()
+	if view.order != nil {
+		for i, displayIndex := range rows {
+			rows[i] = view.order[displayIndex]
+		}
+		sort.Ints(rows)
+	}
+	return rows
+}
+
+// FocusedRow returns the row of the data source that was last clicked or navigated to, or -1 if there is none.
+func (view *TableView) FocusedRow() int {
+	return view.rowAtOrNone(view.selection.Focus())
+}
@@ -0,0 +1,67 @@
+package imgui_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ianling/imgui-go"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type countingDataSource struct {
+	values  []int
+	touched map[int]bool
+}
+
+func (source *countingDataSource) RowCount() int {
+	return len(source.values)
+}
+
+func (source *countingDataSource) CellText(row, column int) string {
+	source.touched[row] = true
+	return fmt.Sprintf("%d", source.values[row])
+}
+
+func renderTableViewFrames(view *imgui.TableView, source imgui.TableDataSource, frames int) {
+	for i := 0; i < frames; i++ {
+		renderTestWindow(func() { view.Render(source) })
+	}
+}
+
+func TestTableViewOnlyRequestsVisibleRows(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 800, Y: 600})
+	defer context.Destroy()
+
+	source := &countingDataSource{values: make([]int, 100000), touched: make(map[int]bool)}
+	view := imgui.NewTableView("values", []imgui.TableViewColumn{{Label: "Value"}})
+	renderTableViewFrames(view, source, 3)
+	source.touched = make(map[int]bool)
+	renderTableViewFrames(view, source, 1)
+
+	assert.True(t, len(source.touched) > 0, "Some rows should be requested")
+	assert.True(t, len(source.touched) < 100, "Only visible rows should be requested, got %d", len(source.touched))
+}
+
+func TestTableViewSortsByDefaultSortColumn(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 800, Y: 600})
+	defer context.Destroy()
+
+	values := []int{30, 10, 20, 5}
+	source := &countingDataSource{values: values, touched: make(map[int]bool)}
+	view := imgui.NewTableView("values", []imgui.TableViewColumn{{
+		Label:   "Value",
+		Flags:   imgui.TableColumnFlagsDefaultSort,
+		Compare: func(a, b int) int { return values[a] - values[b] },
+	}})
+	renderTableViewFrames(view, source, 3)
+
+	sorted := make([]int, len(values))
+	for i := range sorted {
+		sorted[i] = values[view.RowAt(i)]
+	}
+	assert.Equal(t, []int{5, 10, 20, 30}, sorted)
+	if assert.Len(t, view.SortSpecs(), 1) {
+		assert.Equal(t, imgui.SortDirectionAscending, view.SortSpecs()[0].SortDirection)
+	}
+}
@@ -325,7 +325,7 @@ func TableSetColumnIndex(columnN int) bool {
 //   some advanced use cases (e.g. adding custom widgets in header row).
 // - Use TableSetupScrollFreeze() to lock columns/rows so they stay visible when scrolled.
 func TableSetupColumnV(label string, flags TableColumnFlags, initWidthOrHeight float32, userID uint) {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	C.iggTableSetupColumn(labelArg, C.int(flags), C.float(initWidthOrHeight), C.uint(userID))
 }
@@ -347,7 +347,7 @@ func TableHeadersRow() {
 
 // TableHeader submits one header cell manually (rarely used).
 func TableHeader(label string) {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	C.iggTableHeader(labelArg)
 }
@@ -398,6 +398,13 @@ func TableSetBgColor(target TableBgTarget, color Vec4) {
 	TableSetBgColorV(target, color, -1)
 }
 
+// TableSetColumnEnabled changes the enabled/disabled state of a column.
+// Disabled columns are hidden, the same as when the user hides them through the context menu of the header row.
+// The change takes effect in the next frame.
+func TableSetColumnEnabled(columnN int, enabled bool) {
+	C.iggTableSetColumnEnabled(C.int(columnN), castBool(enabled))
+}
+
 // TableSortSpecs is a sort specs
 // Sorting specifications for a table (often handling sort specs for a single column, occasionally more)
 // Obtained by calling TableGetSortSpecs()
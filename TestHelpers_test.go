@@ -0,0 +1,38 @@
+package imgui_test
+
+import (
+	"github.com/ianling/imgui-go"
+)
+
+// newTestContext creates a current context for tests that render frames: It has a display of given size,
+// no ini file and a built font atlas. The caller has to destroy the context.
+func newTestContext(displaySize imgui.Vec2) *imgui.Context {
+	context := imgui.CreateContext(nil)
+	io := imgui.CurrentIO()
+	io.SetIniFilename("")
+	io.SetDisplaySize(displaySize)
+	io.Fonts().TextureDataAlpha8()
+	return context
+}
+
+// renderTestFrame renders a single frame, calling content between NewFrame() and Render().
+func renderTestFrame(content func()) {
+	imgui.NewFrame()
+	content()
+	imgui.Render()
+}
+
+// renderTestWindow renders a single frame with a test window, calling content within the window.
+func renderTestWindow(content func()) {
+	renderTestFrame(func() { testWindow(content) })
+}
+
+// testWindow shows a window that covers the display, without title bar, calling content within the window.
+func testWindow(content func()) {
+	viewport := imgui.MainViewport()
+	imgui.SetNextWindowPos(viewport.Pos())
+	imgui.SetNextWindowSize(viewport.Size())
+	imgui.BeginV("Test", nil, imgui.WindowFlagsNoTitleBar)
+	content()
+	imgui.End()
+}
@@ -0,0 +1,89 @@
+package imgui
+
+// #include "wrapper/TextFilter.h"
+import "C"
+
+// TextFilter is a helper to parse and apply text filters, as edited by the user.
+//
+// The filter text is a comma separated list of terms, such as "error,-debug".
+// Terms prefixed with a '-' exclude texts that contain them, all other terms include texts that contain them.
+// Terms are matched case-insensitively and in the order they are given; the first matching term decides.
+// A text passes if no term matches and the filter has only exclusive terms.
+//
+// The text of the filter is limited to 255 bytes. Use TextMatcher to apply the same filter outside of the
+// UI thread, for example on a snapshot of Text() in a background goroutine.
+//
+// Usage:
+//   filter := imgui.NewTextFilter("")
+//   defer filter.Delete()
+//   ...
+//   filter.Draw("Filter (inc,-exc)", 0)
+//   for _, line := range lines {
+//       if filter.PassFilter(line) {
+//           imgui.Text(line)
+//       }
+//   }
+type TextFilter uintptr
+
+func (filter TextFilter) handle() C.IggTextFilter {
+	return C.IggTextFilter(filter)
+}
+
+// NewTextFilter creates a new filter with the given initial text.
+// Delete must be called on the returned filter.
+func NewTextFilter(defaultFilter string) TextFilter {
+	defaultFilterArg, defaultFilterFin := wrapString(defaultFilter)
+	defer defaultFilterFin()
+	return TextFilter(C.iggNewTextFilter(defaultFilterArg))
+}
+
+// Delete removes the filter and resets it to zero.
+func (filter *TextFilter) Delete() {
+	if *filter != 0 {
+		C.iggTextFilterDelete(filter.handle())
+		*filter = 0
+	}
+}
+
+// Draw submits an InputText() to edit the filter, with an optional width.
+// A width of zero uses the default item width.
+// It returns true if the filter was changed.
+func (filter TextFilter) Draw(label string, width float32) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
+	return C.iggTextFilterDraw(filter.handle(), labelArg, C.float(width)) != 0
+}
+
+// PassFilter returns true if the given text passes the filter.
+func (filter TextFilter) PassFilter(text string) bool {
+	textArg, textFin := wrapString(text)
+	defer textFin()
+	return C.iggTextFilterPassFilter(filter.handle(), textArg) != 0
+}
+
+// IsActive returns true if the filter text is not empty.
+func (filter TextFilter) IsActive() bool {
+	return C.iggTextFilterIsActive(filter.handle()) != 0
+}
+
+// Clear removes all terms of the filter.
+func (filter TextFilter) Clear() {
+	C.iggTextFilterClear(filter.handle())
+}
+
+// Text returns the current text of the filter.
+func (filter TextFilter) Text() string {
+	return C.GoString(C.iggTextFilterText(filter.handle()))
+}
+
+// SetText replaces the text of the filter. Texts longer than 255 bytes are truncated.
+func (filter TextFilter) SetText(text string) {
+	textArg, textFin := wrapString(text)
+	defer textFin()
+	C.iggTextFilterSetText(filter.handle(), textArg)
+}
+
+// Matcher returns a TextMatcher for the current text of the filter, using given mode.
+func (filter TextFilter) Matcher(mode TextMatchMode) (*TextMatcher, error) {
+	return NewTextMatcher(filter.Text(), mode)
+}
@@ -0,0 +1,235 @@
+package imgui
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// TextMatchMode determines how the terms of a TextMatcher are compared.
+type TextMatchMode int
+
+const (
+	// TextMatchModeSubstring matches texts that contain a term, ignoring case. This is what TextFilter does.
+	TextMatchModeSubstring TextMatchMode = iota
+	// TextMatchModeFuzzy matches texts that contain all characters of a term in the same order, ignoring case.
+	// For example, "nwfl" matches "NewFile".
+	TextMatchModeFuzzy
+	// TextMatchModeRegex matches texts with terms that are regular expressions, as of the regexp package.
+	// As terms are separated by commas, use "\x2c" to match a comma.
+	TextMatchModeRegex
+)
+
+// TextMatcher applies a filter with the syntax of TextFilter, without requiring an imgui context.
+// It is immutable and can be used concurrently, for example to filter large lists in background goroutines.
+type TextMatcher struct {
+	filter    string
+	mode      TextMatchMode
+	terms     []textMatcherTerm
+	countGrep int
+}
+
+type textMatcherTerm struct {
+	exclude bool
+	text    string
+	regex   *regexp.Regexp
+}
+
+// NewTextMatcher parses the given filter text, such as "error,-debug", for given mode.
+// An error is returned if a term is not a valid regular expression in TextMatchModeRegex.
+func NewTextMatcher(filter string, mode TextMatchMode) (*TextMatcher, error) {
+	matcher := &TextMatcher{filter: filter, mode: mode}
+	for _, part := range strings.Split(filter, ",") {
+		part = strings.Trim(part, " \t")
+		if len(part) == 0 {
+			continue
+		}
+		var term textMatcherTerm
+		if part[0] == '-' {
+			term.exclude = true
+			part = part[1:]
+		} else {
+			matcher.countGrep++
+		}
+		switch mode {
+		case TextMatchModeRegex:
+			if len(part) > 0 {
+				regex, err := regexp.Compile("(?i)" + part)
+				if err != nil {
+					return nil, fmt.Errorf("invalid filter term %q: %v", part, err)
+				}
+				term.regex = regex
+			}
+		default:
+			term.text = strings.ToLower(part)
+		}
+		matcher.terms = append(matcher.terms, term)
+	}
+	return matcher, nil
+}
+
+// String returns the filter text the matcher was created with.
+func (matcher *TextMatcher) String() string {
+	return matcher.filter
+}
+
+// Mode returns the mode the matcher was created with.
+func (matcher *TextMatcher) Mode() TextMatchMode {
+	return matcher.mode
+}
+
+// IsActive returns true if the filter text is not empty, as TextFilter.IsActive() does.
+func (matcher *TextMatcher) IsActive() bool {
+	return len(matcher.filter) > 0
+}
+
+// Pass returns true if the given text passes the filter.
+func (matcher *TextMatcher) Pass(text string) bool {
+	if len(matcher.terms) == 0 {
+		return true
+	}
+	lowerText := ""
+	if matcher.mode != TextMatchModeRegex {
+		lowerText = strings.ToLower(text)
+	}
+	for _, term := range matcher.terms {
+		var matches bool
+		switch {
+		case term.regex != nil:
+			matches = term.regex.MatchString(text)
+		case len(term.text) == 0:
+			// A lone '-' does not exclude anything, as with TextFilter.
+			matches = false
+		case matcher.mode == TextMatchModeFuzzy:
+			matches = fuzzyContains(lowerText, term.text)
+		default:
+			matches = strings.Contains(lowerText, term.text)
+		}
+		if matches {
+			return !term.exclude
+		}
+	}
+	return matcher.countGrep == 0
+}
+
+// Score returns whether the given text passes the filter, and how well it matches the terms that include texts.
+// In TextMatchModeFuzzy, matches of consecutive characters and of characters at the start of words score higher,
+// so that passing texts can be ranked with the best match first. In the other modes, the score is always zero.
+func (matcher *TextMatcher) Score(text string) (int, bool) {
+	if !matcher.Pass(text) {
+		return 0, false
+	}
+	if matcher.mode != TextMatchModeFuzzy {
+		return 0, true
+	}
+	textRunes := []rune(text)
+	best := 0
+	for _, term := range matcher.terms {
+		if term.exclude || (len(term.text) == 0) {
+			continue
+		}
+		if score, matched := fuzzyScore(textRunes, term.text); matched && (score > best) {
+			best = score
+		}
+	}
+	return best, true
+}
+
+const (
+	fuzzyScoreFirstCharacter = 10
+	fuzzyScoreWordStart      = 8
+	fuzzyScoreCamelCase      = 7
+	fuzzyScoreConsecutive    = 5
+	fuzzyScoreGap            = -1
+	fuzzyScoreUnmatched      = -1 << 30
+)
+
+// fuzzyCharacterBonus returns the score for matching the rune at given index.
+func fuzzyCharacterBonus(text []rune, index int) int {
+	if index == 0 {
+		return fuzzyScoreFirstCharacter
+	}
+	previous, current := text[index-1], text[index]
+	switch {
+	case unicode.IsSpace(previous) || unicode.IsPunct(previous):
+		return fuzzyScoreWordStart
+	case unicode.IsLower(previous) && unicode.IsUpper(current):
+		return fuzzyScoreCamelCase
+	default:
+		return 0
+	}
+}
+
+// fuzzyScore returns the best score of matching all runes of the lower-case pattern in text in the same order.
+// Spaces in the pattern are ignored, as with fuzzyContains().
+func fuzzyScore(text []rune, pattern string) (int, bool) {
+	lowerText := make([]rune, len(text))
+	for index, r := range text {
+		lowerText[index] = unicode.ToLower(r)
+	}
+	// previous[i] is the best score of the pattern so far, with its last rune matched at text[i].
+	previous := make([]int, len(text))
+	current := make([]int, len(text))
+	first := true
+	for _, patternRune := range pattern {
+		if unicode.IsSpace(patternRune) {
+			continue
+		}
+		bestBefore := fuzzyScoreUnmatched
+		for index := range text {
+			if !first && (index > 0) && (previous[index-1] > bestBefore) {
+				bestBefore = previous[index-1]
+			}
+			current[index] = fuzzyScoreUnmatched
+			if lowerText[index] != patternRune {
+				continue
+			}
+			bonus := fuzzyCharacterBonus(text, index)
+			switch {
+			case first:
+				current[index] = bonus
+			case (index > 0) && (previous[index-1] != fuzzyScoreUnmatched):
+				current[index] = previous[index-1] + fuzzyScoreConsecutive + bonus
+				if bestBefore+fuzzyScoreGap+bonus > current[index] {
+					current[index] = bestBefore + fuzzyScoreGap + bonus
+				}
+			case bestBefore != fuzzyScoreUnmatched:
+				current[index] = bestBefore + fuzzyScoreGap + bonus
+			}
+		}
+		previous, current = current, previous
+		first = false
+	}
+	if first {
+		return 0, true
+	}
+	best := fuzzyScoreUnmatched
+	for _, score := range previous {
+		if score > best {
+			best = score
+		}
+	}
+	return best, best != fuzzyScoreUnmatched
+}
+
+// fuzzyContains returns true if all runes of pattern appear in text in the same order.
+// Spaces in the pattern are ignored.
+func fuzzyContains(text, pattern string) bool {
+	patternRunes := []rune(strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, pattern))
+	next := 0
+	for _, r := range text {
+		if next == len(patternRunes) {
+			break
+		}
+		if r == patternRunes[next] {
+			next++
+		}
+	}
+	return next == len(patternRunes)
+}
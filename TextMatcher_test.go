@@ -0,0 +1,81 @@
+package imgui_test
+
+import (
+	"testing"
+
+	"github.com/ianling/imgui-go"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var textFilterSamples = []string{"", "Error: disk full", "debug: error ignored", "info", "DEBUG", "warning, -not"}
+
+func TestTextMatcherMatchesTextFilter(t *testing.T) {
+	filters := []string{"", "error", "error,-debug", "-debug,error", "-debug", " warn , info ", "-", ",,"}
+	for _, filterText := range filters {
+		filter := imgui.NewTextFilter(filterText)
+		matcher, err := imgui.NewTextMatcher(filterText, imgui.TextMatchModeSubstring)
+		require.Nil(t, err)
+		assert.Equal(t, filter.IsActive(), matcher.IsActive(), "IsActive differs for %q", filterText)
+		for _, sample := range textFilterSamples {
+			assert.Equal(t, filter.PassFilter(sample), matcher.Pass(sample), "Result differs for %q on %q", filterText, sample)
+		}
+		filter.Delete()
+	}
+}
+
+func TestTextFilterText(t *testing.T) {
+	filter := imgui.NewTextFilter("abc")
+	defer filter.Delete()
+
+	filter.SetText("error,-debug")
+	assert.Equal(t, "error,-debug", filter.Text())
+	assert.True(t, filter.PassFilter("debug: error"), "First matching term decides")
+	filter.SetText("-debug,error")
+	assert.False(t, filter.PassFilter("debug: error"), "First matching term decides")
+	filter.Clear()
+	assert.False(t, filter.IsActive())
+	assert.Equal(t, "", filter.Text())
+}
+
+func TestTextMatcherFuzzy(t *testing.T) {
+	matcher, err := imgui.NewTextMatcher("-old,nwfl", imgui.TextMatchModeFuzzy)
+	require.Nil(t, err)
+	assert.True(t, matcher.Pass("NewFile"))
+	assert.False(t, matcher.Pass("FileNew"))
+	assert.False(t, matcher.Pass("old new file"))
+}
+
+func TestTextMatcherRegex(t *testing.T) {
+	matcher, err := imgui.NewTextMatcher(`^err\w*:,-warn`, imgui.TextMatchModeRegex)
+	require.Nil(t, err)
+	assert.True(t, matcher.Pass("ERROR: disk full"))
+	assert.False(t, matcher.Pass("an error: disk full"))
+	assert.False(t, matcher.Pass("warning"))
+
+	_, err = imgui.NewTextMatcher("(", imgui.TextMatchModeRegex)
+	assert.NotNil(t, err)
+}
+
+func TestTextMatcherFuzzyScore(t *testing.T) {
+	matcher, err := imgui.NewTextMatcher("nf", imgui.TextMatchModeFuzzy)
+	require.Nil(t, err)
+	wordStarts, wordStartsPass := matcher.Score("New File")
+	camelCase, camelCasePass := matcher.Score("Open newFile")
+	scattered, scatteredPass := matcher.Score("Open Config File")
+	_, unrelatedPass := matcher.Score("Save")
+	assert.True(t, wordStartsPass && camelCasePass && scatteredPass)
+	assert.False(t, unrelatedPass)
+	assert.True(t, wordStarts > camelCase, "First character should score higher")
+	assert.True(t, camelCase > scattered, "Word starts should score higher than characters within words")
+
+	consecutive, _ := matcher.Score("Config: nf")
+	assert.True(t, consecutive > scattered, "Consecutive characters should score higher")
+
+	substring, err := imgui.NewTextMatcher("file", imgui.TextMatchModeSubstring)
+	require.Nil(t, err)
+	score, pass := substring.Score("New File")
+	assert.True(t, pass)
+	assert.Equal(t, 0, score)
+}
@@ -0,0 +1,138 @@
+package imgui
+
+import (
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// TextShaper converts text from logical order, as it is stored, into the visual order in which imgui
+// renders its characters one by one, from left to right. It is applied to single lines without line breaks.
+type TextShaper func(line string) string
+
+// textShapingCacheLimit is the number of shaped strings kept before the cache is started anew.
+const textShapingCacheLimit = 4096
+
+var textShaping = struct {
+	mutex  sync.Mutex
+	shaper TextShaper
+	cache  map[string]string
+}{}
+
+// SetTextShaper sets the shaper that is applied to text passed to Text(), LabelText(), BulletText(), SetTooltip(),
+// DrawList.AddText() and CalcTextSize(), to the hint of input fields, and to the visible part of widget labels and
+// window titles, before a "##". A nil shaper disables shaping. Results are cached.
+//
+// Building with the imguibidi tag registers a shaper based on FriBidi, which reorders right-to-left text
+// and joins Arabic letters using their presentation forms. The used fonts must contain the glyphs
+// of these forms (U+FB50 to U+FDFF and U+FE70 to U+FEFF). Building with the imguiharfbuzz tag provides
+// HarfBuzzTextShaper, which shapes text according to the OpenType tables of a given font file instead.
+//
+// Text() that is wrapped, for example with PushTextWrapPos(), is broken into lines before it is shaped, so that
+// the lines of a right-to-left paragraph keep their order.
+//
+// Shaping may reorder the visible part of a label, which imgui also uses to identify the widget. Such a label is
+// therefore identified by "###" followed by the original label, unless it has an explicit "###" ID already.
+// Popup functions, such as OpenPopup(), apply the same to their IDs, so that they match the names of modal popups.
+func SetTextShaper(shaper TextShaper) {
+	textShaping.mutex.Lock()
+	defer textShaping.mutex.Unlock()
+	textShaping.shaper = shaper
+	textShaping.cache = nil
+}
+
+// ShapeText returns the text as it would be displayed by Text() without wrapping, in visual order.
+// Use it for text that is drawn by other means, such as custom widgets.
+// Text is returned unchanged if no shaper is set, or if it consists only of ASCII characters.
+func ShapeText(text string) string {
+	if isASCII(text) {
+		return text
+	}
+	textShaping.mutex.Lock()
+	defer textShaping.mutex.Unlock()
+	if textShaping.shaper == nil {
+		return text
+	}
+	if shaped, cached := textShaping.cache[text]; cached {
+		return shaped
+	}
+	lines := strings.Split(text, "\n")
+	for index, line := range lines {
+		if !isASCII(line) {
+			lines[index] = textShaping.shaper(line)
+		}
+	}
+	shaped := strings.Join(lines, "\n")
+	if (textShaping.cache == nil) || (len(textShaping.cache) >= textShapingCacheLimit) {
+		textShaping.cache = make(map[string]string)
+	}
+	textShaping.cache[text] = shaped
+	return shaped
+}
+
+// shapeLabel returns the label with its visible part, before a "##", in visual order, and that visible part.
+// If shaping changes the visible part, the ID of the label is kept stable with a "###" suffix.
+func shapeLabel(label string) (shaped, visible string) {
+	visible, id := label, ""
+	if end := strings.Index(label, "##"); end >= 0 {
+		visible, id = label[:end], label[end:]
+	}
+	shapedVisible := ShapeText(visible)
+	if shapedVisible == visible {
+		return label, visible
+	}
+	if !strings.Contains(id, "###") {
+		id = "###" + label
+	}
+	return shapedVisible + id, shapedVisible
+}
+
+// shapedLabel returns the label as shapeLabel() does, for functions that refer to a label without displaying it.
+func shapedLabel(label string) string {
+	shaped, _ := shapeLabel(label)
+	return shaped
+}
+
+// breakWrappedLines returns the text with line breaks where Text() wraps it, if the text is shaped.
+// Shaping wrapped paragraphs as a whole would reverse the order of the lines of right-to-left text.
+func breakWrappedLines(text string) string {
+	if isASCII(text) || !hasTextShaper() {
+		return text
+	}
+	wrapWidth := textWrapWidth()
+	if wrapWidth < 0 {
+		return text
+	}
+	font := CurrentFont()
+	scale := FontSize() / font.FontSize()
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		for {
+			end := font.CalcWordWrapPositionA(scale, paragraph, wrapWidth)
+			if (end == 0) && (paragraph != "") {
+				_, end = utf8.DecodeRuneInString(paragraph)
+			}
+			lines = append(lines, paragraph[:end])
+			paragraph = strings.TrimLeft(paragraph[end:], " \t")
+			if paragraph == "" {
+				break
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func hasTextShaper() bool {
+	textShaping.mutex.Lock()
+	defer textShaping.mutex.Unlock()
+	return textShaping.shaper != nil
+}
+
+func isASCII(text string) bool {
+	for i := 0; i < len(text); i++ {
+		if text[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,49 @@
+// +build imguibidi
+
+package imgui
+
+// #cgo pkg-config: fribidi
+// #include <fribidi.h>
+import "C"
+
+import "strings"
+
+// fribidiCharFill is the zero width character FriBidi leaves in place of a letter that was merged into a ligature.
+const fribidiCharFill = 0xFEFF
+
+// The shaper is based on FriBidi, rather than HarfBuzz: The fonts of imgui map code points to glyphs,
+// so any shaping result must be expressed in code points as well. HarfBuzz results in glyph indices
+// of the font file, which can not be rendered through the font atlas. FriBidi instead provides the
+// Arabic presentation forms, which fonts with Arabic support typically contain.
+func init() {
+	SetTextShaper(shapeWithFriBidi)
+}
+
+// shapeWithFriBidi reorders the line into visual order, mirrors brackets, and joins Arabic letters.
+// The base direction of the line is determined by its first strongly directional character.
+func shapeWithFriBidi(line string) string {
+	runes := []rune(line)
+	if len(runes) == 0 {
+		return line
+	}
+	logical := make([]C.FriBidiChar, len(runes))
+	for index, r := range runes {
+		logical[index] = C.FriBidiChar(r)
+	}
+	visual := make([]C.FriBidiChar, len(runes))
+	baseDir := C.FriBidiParType(C.FRIBIDI_PAR_ON)
+
+	maxLevel := C.fribidi_log2vis(&logical[0], C.FriBidiStrIndex(len(logical)), &baseDir, &visual[0], nil, nil, nil)
+	if maxLevel == 0 {
+		return line
+	}
+
+	var builder strings.Builder
+	builder.Grow(len(line))
+	for _, c := range visual {
+		if c != fribidiCharFill {
+			builder.WriteRune(rune(c))
+		}
+	}
+	return builder.String()
+}
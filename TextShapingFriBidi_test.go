@@ -0,0 +1,24 @@
+// +build imguibidi
+
+package imgui_test
+
+import (
+	"testing"
+
+	"github.com/ianling/imgui-go"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFriBidiShaperReordersRightToLeftText(t *testing.T) {
+	assert.Equal(t, "abc םולש", imgui.ShapeText("שלום abc"), "Text should be in visual order")
+	assert.Equal(t, "abc (ב)א", imgui.ShapeText("abc א(ב)"), "Brackets should be mirrored")
+}
+
+func TestFriBidiShaperJoinsArabicLetters(t *testing.T) {
+	shaped := []rune(imgui.ShapeText("سلام"))
+	assert.True(t, len(shaped) > 0)
+	for _, r := range shaped {
+		assert.True(t, (r >= 0xFB50) && (r <= 0xFEFF), "Character %U should be a presentation form", r)
+	}
+}
@@ -0,0 +1,202 @@
+// +build imguiharfbuzz
+
+package imgui
+
+// #cgo pkg-config: harfbuzz fribidi
+// #include <hb.h>
+// #include <fribidi.h>
+import "C"
+
+import (
+	"errors"
+	"unsafe"
+)
+
+// HarfBuzzTextShaper shapes text with HarfBuzz, using the OpenType tables of a font file, and orders it with FriBidi.
+// It is only available when building with the imguiharfbuzz tag, which requires both libraries.
+//
+// HarfBuzz results in glyphs of the font file, while the fonts of imgui map code points to glyphs. The shaper
+// therefore maps each resulting glyph back to the code point the font file maps to it, such as the Arabic
+// presentation forms. Glyphs without code point, such as ligatures that are only reachable by substitution,
+// are replaced by the first character they were formed from. The font file should be the one the text
+// is displayed with, and the glyph ranges of the font in the atlas have to contain the resulting code points.
+//
+// Usage:
+//   shaper, err := imgui.NewHarfBuzzTextShaper(fontData)
+//   if err == nil {
+//       imgui.SetTextShaper(shaper.Shape)
+//   }
+type HarfBuzzTextShaper struct {
+	font       *C.hb_font_t
+	codepoints map[C.hb_codepoint_t]rune
+}
+
+// NewHarfBuzzTextShaper returns a shaper for the font of given TTF or OTF data.
+// Call Delete() once the shaper is not used anymore.
+func NewHarfBuzzTextShaper(fontData []byte) (*HarfBuzzTextShaper, error) {
+	if len(fontData) == 0 {
+		return nil, errors.New("no font data")
+	}
+	blob := C.hb_blob_create((*C.char)(unsafe.Pointer(&fontData[0])), C.uint(len(fontData)),
+		C.HB_MEMORY_MODE_DUPLICATE, nil, nil)
+	defer C.hb_blob_destroy(blob)
+	face := C.hb_face_create(blob, 0)
+	defer C.hb_face_destroy(face)
+	if C.hb_face_get_glyph_count(face) == 0 {
+		return nil, errors.New("font data contains no glyphs")
+	}
+	shaper := &HarfBuzzTextShaper{
+		font:       C.hb_font_create(face),
+		codepoints: make(map[C.hb_codepoint_t]rune),
+	}
+
+	// Code points are visited in ascending order, so a glyph shared by several of them maps to the lowest one.
+	// This prefers the regular letters over their presentation forms, as the former are more likely loaded.
+	set := C.hb_set_create()
+	defer C.hb_set_destroy(set)
+	C.hb_face_collect_unicodes(face, set)
+	codepoint := C.hb_codepoint_t(^uint32(0))
+	for C.hb_set_next(set, &codepoint) != 0 {
+		var glyph C.hb_codepoint_t
+		if C.hb_font_get_nominal_glyph(shaper.font, codepoint, &glyph) == 0 {
+			continue
+		}
+		if _, known := shaper.codepoints[glyph]; !known {
+			shaper.codepoints[glyph] = rune(codepoint)
+		}
+	}
+	return shaper, nil
+}
+
+// Delete releases the resources of the shaper. It must not be used afterwards.
+func (shaper *HarfBuzzTextShaper) Delete() {
+	if shaper.font != nil {
+		C.hb_font_destroy(shaper.font)
+		shaper.font = nil
+	}
+}
+
+// Shape returns the line in visual order, with its characters shaped by the font. It is a TextShaper.
+// The base direction of the line is determined by its first strongly directional character.
+func (shaper *HarfBuzzTextShaper) Shape(line string) string {
+	runes := []rune(line)
+	if len(runes) == 0 {
+		return line
+	}
+	levels := bidiLevels(runes)
+	if levels == nil {
+		return line
+	}
+
+	var runs []harfBuzzRun
+	for start := 0; start < len(runes); {
+		end := start + 1
+		for (end < len(runes)) && (levels[end] == levels[start]) {
+			end++
+		}
+		runs = append(runs, harfBuzzRun{level: levels[start], text: shaper.shapeRun(runes[start:end], levels[start]%2 != 0)})
+		start = end
+	}
+	reorderRuns(runs)
+
+	var visual []rune
+	for _, run := range runs {
+		visual = append(visual, run.text...)
+	}
+	return string(visual)
+}
+
+type harfBuzzRun struct {
+	level int
+	text  []rune
+}
+
+// shapeRun shapes text of a single direction. HarfBuzz returns the glyphs of right-to-left text in visual order.
+func (shaper *HarfBuzzTextShaper) shapeRun(text []rune, rightToLeft bool) []rune {
+	codepoints := make([]C.uint32_t, len(text))
+	for index, r := range text {
+		codepoints[index] = C.uint32_t(r)
+	}
+	buffer := C.hb_buffer_create()
+	defer C.hb_buffer_destroy(buffer)
+	C.hb_buffer_add_utf32(buffer, &codepoints[0], C.int(len(codepoints)), 0, C.int(len(codepoints)))
+	if rightToLeft {
+		C.hb_buffer_set_direction(buffer, C.HB_DIRECTION_RTL)
+	} else {
+		C.hb_buffer_set_direction(buffer, C.HB_DIRECTION_LTR)
+	}
+	C.hb_buffer_guess_segment_properties(buffer)
+	C.hb_shape(shaper.font, buffer, nil, 0)
+
+	var count C.uint
+	infos := C.hb_buffer_get_glyph_infos(buffer, &count)
+	if count == 0 {
+		return text
+	}
+	glyphs := (*[1 << 28]C.hb_glyph_info_t)(unsafe.Pointer(infos))[:count:count]
+	shaped := make([]rune, 0, len(glyphs))
+	for _, glyph := range glyphs {
+		if r, known := shaper.codepoints[glyph.codepoint]; known {
+			shaped = append(shaped, r)
+		} else if int(glyph.cluster) < len(text) {
+			shaped = append(shaped, text[glyph.cluster])
+		}
+	}
+	return shaped
+}
+
+// bidiLevels returns the embedding level of each character, as determined by FriBidi, or nil on failure.
+func bidiLevels(runes []rune) []int {
+	logical := make([]C.FriBidiChar, len(runes))
+	for index, r := range runes {
+		logical[index] = C.FriBidiChar(r)
+	}
+	length := C.FriBidiStrIndex(len(logical))
+	types := make([]C.FriBidiCharType, len(logical))
+	C.fribidi_get_bidi_types(&logical[0], length, &types[0])
+	brackets := make([]C.FriBidiBracketType, len(logical))
+	C.fribidi_get_bracket_types(&logical[0], length, &types[0], &brackets[0])
+	embeddingLevels := make([]C.FriBidiLevel, len(logical))
+	baseDir := C.FriBidiParType(C.FRIBIDI_PAR_ON)
+	if C.fribidi_get_par_embedding_levels_ex(&types[0], &brackets[0], length, &baseDir, &embeddingLevels[0]) == 0 {
+		return nil
+	}
+	levels := make([]int, len(embeddingLevels))
+	for index, level := range embeddingLevels {
+		levels[index] = int(level)
+	}
+	return levels
+}
+
+// reorderRuns brings runs of shaped text into visual order: From the highest level down to the lowest odd one,
+// each sequence of runs at that level or higher is reversed.
+func reorderRuns(runs []harfBuzzRun) {
+	highest, lowestOdd := 0, -1
+	for _, run := range runs {
+		if run.level > highest {
+			highest = run.level
+		}
+		if (run.level%2 != 0) && ((lowestOdd < 0) || (run.level < lowestOdd)) {
+			lowestOdd = run.level
+		}
+	}
+	if lowestOdd < 0 {
+		return
+	}
+	for level := highest; level >= lowestOdd; level-- {
+		for start := 0; start < len(runs); {
+			if runs[start].level < level {
+				start++
+				continue
+			}
+			end := start + 1
+			for (end < len(runs)) && (runs[end].level >= level) {
+				end++
+			}
+			for i, j := start, end-1; i < j; i, j = i+1, j-1 {
+				runs[i], runs[j] = runs[j], runs[i]
+			}
+			start = end
+		}
+	}
+}
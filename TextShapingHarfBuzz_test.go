@@ -0,0 +1,36 @@
+// +build imguiharfbuzz
+
+package imgui_test
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/ianling/imgui-go"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHarfBuzzTextShaperRejectsInvalidFontData(t *testing.T) {
+	_, err := imgui.NewHarfBuzzTextShaper(nil)
+	assert.NotNil(t, err, "Missing data should be rejected")
+	_, err = imgui.NewHarfBuzzTextShaper([]byte("no font"))
+	assert.NotNil(t, err, "Data without glyphs should be rejected")
+}
+
+func TestHarfBuzzTextShaperShapesText(t *testing.T) {
+	fontData, err := ioutil.ReadFile("imgui/misc/fonts/DroidSans.ttf")
+	if err != nil {
+		t.Fatalf("Font should be readable: %v", err)
+	}
+	shaper, err := imgui.NewHarfBuzzTextShaper(fontData)
+	if err != nil {
+		t.Fatalf("Font should be accepted: %v", err)
+	}
+	defer shaper.Delete()
+
+	assert.Equal(t, "Shaped text", shaper.Shape("Shaped text"), "Left-to-right text should be kept")
+	// The font has no Hebrew glyphs, so the characters are kept, but reordered.
+	assert.Equal(t, "abc גבא", shaper.Shape("abc אבג"), "Right-to-left run should be reversed")
+	assert.Equal(t, "abc גבא", shaper.Shape("אבג abc"), "Right-to-left line should start at the right")
+}
@@ -0,0 +1,72 @@
+package imgui_test
+
+import (
+	"testing"
+
+	"github.com/ianling/imgui-go"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func reverseText(line string) string {
+	runes := []rune(line)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes)
+}
+
+func TestShapeTextAppliesShaperPerLine(t *testing.T) {
+	calls := 0
+	imgui.SetTextShaper(func(line string) string {
+		calls++
+		return reverseText(line)
+	})
+	defer imgui.SetTextShaper(nil)
+
+	assert.Equal(t, "abc", imgui.ShapeText("abc"), "ASCII text should be kept")
+	assert.Equal(t, "אבג\nabc\nוה", imgui.ShapeText("גבא\nabc\nהו"))
+	assert.Equal(t, 2, calls, "Only lines with non-ASCII characters should be shaped")
+	imgui.ShapeText("גבא\nabc\nהו")
+	assert.Equal(t, 2, calls, "Shaped text should be cached")
+}
+
+func TestShapeTextWithoutShaper(t *testing.T) {
+	imgui.SetTextShaper(nil)
+	assert.Equal(t, "גבא", imgui.ShapeText("גבא"))
+}
+
+func TestWrappedTextIsShapedPerVisualLine(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 800, Y: 600})
+	defer context.Destroy()
+	var shapedLines []string
+	imgui.SetTextShaper(func(line string) string {
+		shapedLines = append(shapedLines, line)
+		return reverseText(line)
+	})
+	defer imgui.SetTextShaper(nil)
+
+	renderTestWindow(func() {
+		imgui.PushTextWrapPosV(imgui.CursorPosX() + imgui.CalcTextSize("ab", false, 0).X)
+		imgui.Text("אב גד\nהו")
+		imgui.PopTextWrapPos()
+	})
+	assert.Equal(t, []string{"אב", "גד", "הו"}, shapedLines, "Lines should be broken before they are shaped")
+}
+
+func TestShapedLabelsKeepTheirID(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 800, Y: 600})
+	defer context.Destroy()
+	imgui.SetTextShaper(reverseText)
+	defer imgui.SetTextShaper(nil)
+
+	var open bool
+	renderTestWindow(func() {
+		imgui.OpenPopup("אב")
+		open = imgui.BeginPopupModal("אב")
+		if open {
+			imgui.EndPopup()
+		}
+	})
+	assert.True(t, open, "Shaped popup name should match the ID passed to OpenPopup()")
+}
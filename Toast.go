@@ -0,0 +1,407 @@
+package imgui
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ToastSeverity determines the icon and color of a Toast.
+type ToastSeverity int
+
+const (
+	// ToastSeverityInfo is for neutral information, such as "Connected".
+	ToastSeverityInfo ToastSeverity = iota
+	// ToastSeveritySuccess is for completed operations, such as "Saved".
+	ToastSeveritySuccess
+	// ToastSeverityWarning is for problems that did not stop an operation.
+	ToastSeverityWarning
+	// ToastSeverityError is for failed operations, such as "Export failed".
+	ToastSeverityError
+)
+
+// Color returns the color the severity is displayed with.
+func (severity ToastSeverity) Color() Vec4 {
+	switch severity {
+	case ToastSeveritySuccess:
+		return Vec4{X: 0.30, Y: 0.75, Z: 0.35, W: 1}
+	case ToastSeverityWarning:
+		return Vec4{X: 0.95, Y: 0.70, Z: 0.20, W: 1}
+	case ToastSeverityError:
+		return Vec4{X: 0.90, Y: 0.30, Z: 0.30, W: 1}
+	default:
+		return Vec4{X: 0.35, Y: 0.60, Z: 0.95, W: 1}
+	}
+}
+
+// String returns the name of the severity.
+func (severity ToastSeverity) String() string {
+	switch severity {
+	case ToastSeveritySuccess:
+		return "Success"
+	case ToastSeverityWarning:
+		return "Warning"
+	case ToastSeverityError:
+		return "Error"
+	default:
+		return "Info"
+	}
+}
+
+// ToastCorner is the corner of the work area of the main viewport in which toasts are stacked.
+type ToastCorner int
+
+const (
+	// ToastCornerBottomRight stacks toasts upwards from the bottom right corner.
+	ToastCornerBottomRight ToastCorner = iota
+	// ToastCornerBottomLeft stacks toasts upwards from the bottom left corner.
+	ToastCornerBottomLeft
+	// ToastCornerTopRight stacks toasts downwards from the top right corner.
+	ToastCornerTopRight
+	// ToastCornerTopLeft stacks toasts downwards from the top left corner.
+	ToastCornerTopLeft
+)
+
+// DefaultToastDuration is the time a toast is displayed for if its duration is zero.
+const DefaultToastDuration = 4 * time.Second
+
+// Toast is a notification that is displayed for a while without blocking the user.
+type Toast struct {
+	// Severity determines icon and color.
+	Severity ToastSeverity
+	// Title is displayed above the text, if not empty.
+	Title string
+	// Text is the message of the toast.
+	Text string
+	// Duration is the time the toast is displayed for, not counting the time the mouse hovers it.
+	// Zero uses DefaultToastDuration, a negative duration displays the toast until it is clicked.
+	Duration time.Duration
+	// ActionLabel is the label of a button that calls Action and dismisses the toast. Without label, there is no button.
+	ActionLabel string
+	// Action is called from Render() if the action button is clicked.
+	Action func()
+	// Posted is the time the toast was posted at. It is set by Post().
+	Posted time.Time
+}
+
+type shownToast struct {
+	Toast
+	id        int
+	elapsed   float64
+	lastTime  float64
+	dismissAt float64
+	height    float32
+}
+
+// Toasts displays notifications stacked in a corner of the work area of the main viewport, and keeps their history.
+//
+// Toasts can be posted from any goroutine. They fade in, are shown for their duration, and fade out again.
+// The time does not run out while the mouse hovers a toast, and a click on it dismisses it.
+//
+// Usage:
+//   toasts := imgui.NewToasts()
+//   go func() {
+//       toasts.Post(imgui.Toast{Severity: imgui.ToastSeveritySuccess, Text: "Saved"})
+//   }()
+//   ...
+//   // once per frame, after the other windows
+//   toasts.Render()
+type Toasts struct {
+	// Corner is the corner the toasts are stacked in.
+	Corner ToastCorner
+	// MaxVisible is the number of toasts shown at once. Further toasts are shown once others disappeared.
+	// Zero shows all toasts at once.
+	MaxVisible int
+	// Width is the width the text of a toast is wrapped at.
+	Width float32
+	// Padding is the distance of the toasts from the corner, and between each other.
+	Padding float32
+	// FadeDuration is the time toasts take to fade in and out.
+	FadeDuration time.Duration
+	// HistoryLimit is the number of toasts that are kept in the history. A negative limit keeps all toasts.
+	HistoryLimit int
+
+	mutex   sync.Mutex
+	pending []Toast
+	history []Toast
+
+	shown []*shownToast
+	// freeIDs are the window ids of toasts that disappeared. They are reused, as imgui keeps every window
+	// it ever created.
+	freeIDs []int
+	nextID  int
+}
+
+// NewToasts returns an empty instance with default settings.
+func NewToasts() *Toasts {
+	return &Toasts{
+		Corner:       ToastCornerBottomRight,
+		MaxVisible:   5,
+		Width:        300,
+		Padding:      10,
+		FadeDuration: 200 * time.Millisecond,
+		HistoryLimit: 100,
+	}
+}
+
+// Post queues a toast to be displayed. It is safe to call from any goroutine.
+func (toasts *Toasts) Post(toast Toast) {
+	toast.Posted = time.Now()
+	toasts.mutex.Lock()
+	defer toasts.mutex.Unlock()
+	toasts.pending = append(toasts.pending, toast)
+	toasts.history = append(toasts.history, toast)
+	if (toasts.HistoryLimit >= 0) && (len(toasts.history) > toasts.HistoryLimit) {
+		toasts.history = append([]Toast(nil), toasts.history[len(toasts.history)-toasts.HistoryLimit:]...)
+	}
+}
+
+// Info posts a toast of severity ToastSeverityInfo with given text.
+func (toasts *Toasts) Info(text string) {
+	toasts.Post(Toast{Severity: ToastSeverityInfo, Text: text})
+}
+
+// Success posts a toast of severity ToastSeveritySuccess with given text.
+func (toasts *Toasts) Success(text string) {
+	toasts.Post(Toast{Severity: ToastSeveritySuccess, Text: text})
+}
+
+// Warning posts a toast of severity ToastSeverityWarning with given text.
+func (toasts *Toasts) Warning(text string) {
+	toasts.Post(Toast{Severity: ToastSeverityWarning, Text: text})
+}
+
+// Error posts a toast of severity ToastSeverityError with given text.
+func (toasts *Toasts) Error(text string) {
+	toasts.Post(Toast{Severity: ToastSeverityError, Text: text})
+}
+
+// History returns the toasts that were posted, oldest first. It is safe to call from any goroutine.
+func (toasts *Toasts) History() []Toast {
+	toasts.mutex.Lock()
+	defer toasts.mutex.Unlock()
+	return append([]Toast(nil), toasts.history...)
+}
+
+// ClearHistory removes all toasts from the history. It is safe to call from any goroutine.
+func (toasts *Toasts) ClearHistory() {
+	toasts.mutex.Lock()
+	defer toasts.mutex.Unlock()
+	toasts.history = nil
+}
+
+// VisibleCount returns the number of toasts that are currently displayed.
+func (toasts *Toasts) VisibleCount() int {
+	return len(toasts.shown)
+}
+
+func (toasts *Toasts) takePending(count int) []Toast {
+	toasts.mutex.Lock()
+	defer toasts.mutex.Unlock()
+	if (count < 0) || (count > len(toasts.pending)) {
+		count = len(toasts.pending)
+	}
+	taken := toasts.pending[:count:count]
+	toasts.pending = toasts.pending[count:]
+	return taken
+}
+
+// Render displays the current toasts. Call it once per frame, after the other windows, so that the toasts
+// are displayed on top of them.
+func (toasts *Toasts) Render() {
+	now := Time()
+	fade := toasts.FadeDuration.Seconds()
+	available := -1
+	if toasts.MaxVisible > 0 {
+		available = toasts.MaxVisible - len(toasts.shown)
+	}
+	for _, toast := range toasts.takePending(available) {
+		toasts.shown = append(toasts.shown, &shownToast{Toast: toast, id: toasts.windowID(), lastTime: now, dismissAt: -1})
+	}
+
+	viewport := MainViewport()
+	workPos, workSize := viewport.WorkPos(), viewport.WorkSize()
+	right := (toasts.Corner == ToastCornerBottomRight) || (toasts.Corner == ToastCornerTopRight)
+	bottom := (toasts.Corner == ToastCornerBottomRight) || (toasts.Corner == ToastCornerBottomLeft)
+	pos := Vec2{X: workPos.X + toasts.Padding, Y: workPos.Y + toasts.Padding}
+	var pivot Vec2
+	if right {
+		pos.X = workPos.X + workSize.X - toasts.Padding
+		pivot.X = 1
+	}
+	if bottom {
+		pos.Y = workPos.Y + workSize.Y - toasts.Padding
+		pivot.Y = 1
+	}
+
+	remaining := toasts.shown[:0]
+	for _, toast := range toasts.shown {
+		if !toasts.renderToast(toast, pos, pivot, now, fade) {
+			toasts.freeIDs = append(toasts.freeIDs, toast.id)
+			continue
+		}
+		remaining = append(remaining, toast)
+		offset := toast.height + toasts.Padding
+		if bottom {
+			pos.Y -= offset
+		} else {
+			pos.Y += offset
+		}
+	}
+	for index := len(remaining); index < len(toasts.shown); index++ {
+		toasts.shown[index] = nil
+	}
+	toasts.shown = remaining
+}
+
+// windowID returns the id for the window of a new toast, reusing the one of a toast that disappeared if possible.
+func (toasts *Toasts) windowID() int {
+	if count := len(toasts.freeIDs); count > 0 {
+		id := toasts.freeIDs[count-1]
+		toasts.freeIDs = toasts.freeIDs[:count-1]
+		return id
+	}
+	id := toasts.nextID
+	toasts.nextID++
+	return id
+}
+
+// renderToast displays a single toast, returning false once it disappeared.
+func (toasts *Toasts) renderToast(toast *shownToast, pos, pivot Vec2, now, fade float64) bool {
+	duration := toast.Duration.Seconds()
+	if toast.Duration == 0 {
+		duration = DefaultToastDuration.Seconds()
+	}
+	end := toast.dismissAt
+	if (end < 0) && (toast.Duration >= 0) {
+		end = duration
+	}
+	if (end >= 0) && (toast.elapsed >= end) {
+		return false
+	}
+	alpha := 1.0
+	if fade > 0 {
+		alpha = toast.elapsed / fade
+		if (end >= 0) && ((end-toast.elapsed)/fade < alpha) {
+			alpha = (end - toast.elapsed) / fade
+		}
+		if alpha > 1 {
+			alpha = 1
+		}
+	}
+
+	SetNextWindowPosV(pos, ConditionAlways, pivot)
+	SetNextWindowBgAlpha(0.9)
+	PushStyleVarFloat(StyleVarAlpha, float32(alpha))
+	flags := WindowFlagsNoDecoration | WindowFlagsAlwaysAutoResize | WindowFlagsNoSavedSettings |
+		WindowFlagsNoFocusOnAppearing | WindowFlagsNoNav | WindowFlagsNoMove
+	hovered := false
+	if BeginV("##toast"+strconv.Itoa(toast.id), nil, flags) {
+		renderToastIcon(toast.Severity, float32(alpha))
+		SameLine()
+		BeginGroup()
+		PushTextWrapPosV(CursorPosX() + toasts.Width)
+		if toast.Title != "" {
+			PushStyleColor(StyleColorText, toast.Severity.Color())
+			Text(toast.Title)
+			PopStyleColor()
+		}
+		Text(toast.Text)
+		PopTextWrapPos()
+		actionHovered := false
+		if toast.ActionLabel != "" {
+			if Button(toast.ActionLabel) {
+				if toast.Action != nil {
+					toast.Action()
+				}
+				toasts.dismiss(toast, fade)
+			}
+			actionHovered = IsItemHovered()
+		}
+		EndGroup()
+		hovered = IsWindowHovered()
+		if hovered && !actionHovered && IsMouseClicked(0) {
+			toasts.dismiss(toast, fade)
+		}
+		toast.height = WindowSize().Y
+	}
+	End()
+	PopStyleVar()
+
+	if !hovered || (toast.dismissAt >= 0) {
+		toast.elapsed += now - toast.lastTime
+	}
+	toast.lastTime = now
+	return true
+}
+
+// dismiss lets the toast fade out from now on.
+func (toasts *Toasts) dismiss(toast *shownToast, fade float64) {
+	if toast.dismissAt < 0 {
+		toast.dismissAt = toast.elapsed + fade
+	}
+}
+
+// renderToastIcon draws the icon of a severity as an item of the height of a line of text.
+func renderToastIcon(severity ToastSeverity, alpha float32) {
+	size := FontSize()
+	min := CursorScreenPos()
+	Dummy(Vec2{X: size, Y: size})
+	drawList := WindowDrawList()
+	severityColor := severity.Color()
+	severityColor.W *= alpha
+	color := PackedColorFromVec4(severityColor)
+	white := PackedColorFromVec4(Vec4{X: 1, Y: 1, Z: 1, W: alpha})
+	center := Vec2{X: min.X + size/2, Y: min.Y + size/2}
+	radius := size / 2
+	switch severity {
+	case ToastSeverityWarning:
+		drawList.AddTriangleFilled(Vec2{X: center.X, Y: min.Y}, Vec2{X: min.X + size, Y: min.Y + size},
+			Vec2{X: min.X, Y: min.Y + size}, color)
+		drawList.AddLineV(Vec2{X: center.X, Y: min.Y + size*0.35}, Vec2{X: center.X, Y: min.Y + size*0.7}, white, 2)
+		drawList.AddCircleFilled(Vec2{X: center.X, Y: min.Y + size*0.85}, 1, white)
+	case ToastSeverityError:
+		drawList.AddCircleFilled(center, radius, color)
+		inset := radius * 0.45
+		drawList.AddLineV(center.Minus(Vec2{X: inset, Y: inset}), center.Plus(Vec2{X: inset, Y: inset}), white, 2)
+		drawList.AddLineV(center.Plus(Vec2{X: inset, Y: -inset}), center.Plus(Vec2{X: -inset, Y: inset}), white, 2)
+	case ToastSeveritySuccess:
+		drawList.AddCircleFilled(center, radius, color)
+		drawList.AddLineV(Vec2{X: min.X + size*0.28, Y: min.Y + size*0.52}, Vec2{X: min.X + size*0.45, Y: min.Y + size*0.7},
+			white, 2)
+		drawList.AddLineV(Vec2{X: min.X + size*0.45, Y: min.Y + size*0.7}, Vec2{X: min.X + size*0.74, Y: min.Y + size*0.32},
+			white, 2)
+	default:
+		drawList.AddCircleFilled(center, radius, color)
+		drawList.AddCircleFilled(Vec2{X: center.X, Y: min.Y + size*0.28}, 1.2, white)
+		drawList.AddLineV(Vec2{X: center.X, Y: min.Y + size*0.45}, Vec2{X: center.X, Y: min.Y + size*0.78}, white, 2)
+	}
+}
+
+// RenderHistory displays the posted toasts, newest first, within the current window.
+func (toasts *Toasts) RenderHistory() {
+	history := toasts.History()
+	if Button("Clear") {
+		toasts.ClearHistory()
+		history = nil
+	}
+	if len(history) == 0 {
+		Text("No notifications")
+		return
+	}
+	for index := len(history) - 1; index >= 0; index-- {
+		toast := history[index]
+		renderToastIcon(toast.Severity, 1)
+		SameLine()
+		Text(toast.Posted.Format("15:04:05"))
+		SameLine()
+		BeginGroup()
+		if toast.Title != "" {
+			PushStyleColor(StyleColorText, toast.Severity.Color())
+			Text(toast.Title)
+			PopStyleColor()
+		}
+		Text(toast.Text)
+		EndGroup()
+	}
+}
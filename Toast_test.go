@@ -0,0 +1,83 @@
+package imgui_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ianling/imgui-go"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func renderToastFrames(toasts *imgui.Toasts, frames int, deltaTime float32) {
+	io := imgui.CurrentIO()
+	io.SetDeltaTime(deltaTime)
+	for frame := 0; frame < frames; frame++ {
+		renderTestFrame(toasts.Render)
+	}
+}
+
+func TestToastsExpire(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 600, Y: 400})
+	defer context.Destroy()
+
+	toasts := imgui.NewToasts()
+	toasts.MaxVisible = 2
+	var wg sync.WaitGroup
+	for _, text := range []string{"Saved", "Connected", "Export failed"} {
+		wg.Add(1)
+		go func(text string) {
+			defer wg.Done()
+			toasts.Info(text)
+		}(text)
+	}
+	wg.Wait()
+	toasts.Post(imgui.Toast{Severity: imgui.ToastSeverityWarning, Text: "Sticky", Duration: -1})
+
+	renderToastFrames(toasts, 2, 0.1)
+	assert.Equal(t, 2, toasts.VisibleCount(), "Only MaxVisible toasts should be shown")
+	renderToastFrames(toasts, 20, 1)
+	assert.Equal(t, 1, toasts.VisibleCount(), "Only the sticky toast should remain")
+
+	history := toasts.History()
+	require.Len(t, history, 4)
+	assert.Equal(t, "Sticky", history[3].Text)
+	assert.False(t, history[3].Posted.IsZero())
+
+	renderTestWindow(toasts.RenderHistory)
+}
+
+func TestToastsClickAndAction(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 600, Y: 400})
+	defer context.Destroy()
+	io := imgui.CurrentIO()
+
+	toasts := imgui.NewToasts()
+	toasts.HistoryLimit = 1
+	toasts.Post(imgui.Toast{Severity: imgui.ToastSeverityError, Title: "Export", Text: "Export failed",
+		Duration: time.Hour})
+	renderToastFrames(toasts, 3, 0.1)
+
+	io.SetMousePosition(imgui.Vec2{X: 585, Y: 385})
+	io.SetMouseButtonDown(0, true)
+	renderToastFrames(toasts, 1, 0.1)
+	io.SetMouseButtonDown(0, false)
+	renderToastFrames(toasts, 5, 0.1)
+	assert.Equal(t, 0, toasts.VisibleCount(), "Click should dismiss the toast")
+
+	retried := 0
+	toasts.Post(imgui.Toast{Text: "Disconnected", ActionLabel: "Retry", Action: func() { retried++ }})
+	assert.Len(t, toasts.History(), 1, "History should be limited")
+	renderToastFrames(toasts, 3, 0.1)
+	// The button is the last item of the toast in the bottom right corner.
+	io.SetMousePosition(imgui.Vec2{X: 520, Y: 372})
+	for _, down := range []bool{true, false, false} {
+		io.SetMouseButtonDown(0, down)
+		renderToastFrames(toasts, 1, 0.1)
+	}
+	renderToastFrames(toasts, 5, 0.1)
+	assert.Equal(t, 1, retried, "Action should be called")
+	assert.Equal(t, 0, toasts.VisibleCount())
+}
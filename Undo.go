@@ -0,0 +1,444 @@
+package imgui
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// UndoCommand is an entry of an UndoStack. The command has already been applied when it is pushed.
+type UndoCommand interface {
+	// Name describes the command, such as "Move node". It is displayed in the history.
+	Name() string
+	// Undo reverts the effect of the command.
+	Undo()
+	// Redo applies the command again after it was undone.
+	Redo()
+}
+
+// UndoMerger is an optional interface of an UndoCommand, to combine it with a command that directly follows it.
+// For example, every frame of a drag can push a command, which are then merged into one entry.
+type UndoMerger interface {
+	// Merge returns true if next was combined into the command, so that undoing the command also reverts next.
+	Merge(next UndoCommand) bool
+}
+
+// ValueChange is an UndoCommand that sets the value a pointer points to.
+type ValueChange struct {
+	name   string
+	target reflect.Value
+	before reflect.Value
+	after  reflect.Value
+}
+
+// NewValueChange returns a command that changes the value target points to from before to after.
+// target must be a non-nil pointer, and before and after must be assignable to the value it points to.
+// Values are copied as by assignment: Slices and maps are not cloned.
+func NewValueChange(name string, target interface{}, before, after interface{}) *ValueChange {
+	targetValue := reflect.ValueOf(target)
+	if (targetValue.Kind() != reflect.Ptr) || targetValue.IsNil() {
+		panic(fmt.Sprintf("imgui: value change %q needs a non-nil pointer, got %T", name, target))
+	}
+	elemType := targetValue.Type().Elem()
+	copyValue := func(value interface{}) reflect.Value {
+		copied := reflect.New(elemType).Elem()
+		copied.Set(reflect.ValueOf(value))
+		return copied
+	}
+	return &ValueChange{name: name, target: targetValue, before: copyValue(before), after: copyValue(after)}
+}
+
+// Name returns the name of the change.
+func (change *ValueChange) Name() string {
+	return change.name
+}
+
+// Undo sets the value before the change.
+func (change *ValueChange) Undo() {
+	change.target.Elem().Set(change.before)
+}
+
+// Redo sets the value after the change.
+func (change *ValueChange) Redo() {
+	change.target.Elem().Set(change.after)
+}
+
+// Merge combines changes of the same target: The merged change keeps the value before this change,
+// and the value after next.
+func (change *ValueChange) Merge(next UndoCommand) bool {
+	other, isChange := next.(*ValueChange)
+	if !isChange || (other.target.Pointer() != change.target.Pointer()) || (other.name != change.name) {
+		return false
+	}
+	change.after = other.after
+	return true
+}
+
+// undoGroup is the command of a transaction.
+type undoGroup struct {
+	name     string
+	commands []UndoCommand
+}
+
+func (group *undoGroup) Name() string {
+	return group.name
+}
+
+func (group *undoGroup) Undo() {
+	for index := len(group.commands) - 1; index >= 0; index-- {
+		group.commands[index].Undo()
+	}
+}
+
+func (group *undoGroup) Redo() {
+	for _, command := range group.commands {
+		command.Redo()
+	}
+}
+
+// add appends a command to the group, merging it with the last one if possible.
+func (group *undoGroup) add(command UndoCommand) {
+	if count := len(group.commands); count > 0 {
+		if merger, canMerge := group.commands[count-1].(UndoMerger); canMerge && merger.Merge(command) {
+			return
+		}
+	}
+	group.commands = append(group.commands, command)
+}
+
+// UndoStack records commands to undo and redo them.
+//
+// Commands pushed in consecutive frames are merged if the previous one implements UndoMerger and accepts the next,
+// so that continuous edits such as drags become one entry. Commands pushed between BeginTransaction() and
+// EndTransaction() are combined into one named entry.
+//
+// The widget helpers, such as Checkbox() or DragFloat(), and Edit() for any other widget, record the value
+// before and after an edit of the pointer they modify. An edit lasts while the widget is active, so that dragging
+// a slider results in one entry.
+//
+// Usage:
+//   history := imgui.NewUndoStack()
+//   ...
+//   history.HandleShortcuts()
+//   history.DragFloat("Speed", &speed)
+//   history.Edit("Position", &position, func() bool { return imgui.DragFloat2("Position", &position) })
+//   if imgui.Button("Reset all") {
+//       history.BeginTransaction("Reset all")
+//       history.Do(imgui.NewValueChange("Speed", &speed, speed, float32(1)))
+//       history.Do(imgui.NewValueChange("Position", &position, position, [2]float32{}))
+//       history.EndTransaction()
+//   }
+type UndoStack struct {
+	// Limit is the maximum number of entries that can be undone. Zero means unlimited.
+	Limit int
+
+	done   []UndoCommand
+	undone []UndoCommand
+
+	lastPushFrame int
+	transaction   *undoGroup
+	depth         int
+
+	edits map[uintptr]reflect.Value
+}
+
+// NewUndoStack returns an empty stack without limit.
+func NewUndoStack() *UndoStack {
+	return &UndoStack{lastPushFrame: -2, edits: make(map[uintptr]reflect.Value)}
+}
+
+// Push records a command that has already been applied. It discards all undone commands.
+func (stack *UndoStack) Push(command UndoCommand) {
+	stack.undone = nil
+	if stack.transaction != nil {
+		stack.transaction.add(command)
+		return
+	}
+	frame := FrameCount()
+	continuous := frame-stack.lastPushFrame <= 1
+	stack.lastPushFrame = frame
+	if count := len(stack.done); continuous && (count > 0) {
+		if merger, canMerge := stack.done[count-1].(UndoMerger); canMerge && merger.Merge(command) {
+			return
+		}
+	}
+	stack.done = append(stack.done, command)
+	if (stack.Limit > 0) && (len(stack.done) > stack.Limit) {
+		stack.done = append([]UndoCommand(nil), stack.done[len(stack.done)-stack.Limit:]...)
+	}
+}
+
+// Do applies a command by calling its Redo(), and pushes it.
+func (stack *UndoStack) Do(command UndoCommand) {
+	command.Redo()
+	stack.Push(command)
+}
+
+// BeginTransaction starts to combine all pushed commands into one entry with given name.
+// Transactions can be nested, in which case the outermost one determines the entry.
+func (stack *UndoStack) BeginTransaction(name string) {
+	stack.depth++
+	if stack.depth == 1 {
+		stack.transaction = &undoGroup{name: name}
+	}
+}
+
+// EndTransaction ends the transaction started with BeginTransaction().
+// The outermost transaction is pushed as one entry, unless it is empty.
+func (stack *UndoStack) EndTransaction() {
+	if stack.depth == 0 {
+		return
+	}
+	stack.depth--
+	if stack.depth > 0 {
+		return
+	}
+	group := stack.transaction
+	stack.transaction = nil
+	if len(group.commands) > 0 {
+		stack.lastPushFrame = -2
+		stack.Push(group)
+	}
+}
+
+// CanUndo returns true if there is a command to undo, and no transaction is in progress.
+func (stack *UndoStack) CanUndo() bool {
+	return (len(stack.done) > 0) && (stack.transaction == nil)
+}
+
+// CanRedo returns true if there is an undone command to redo, and no transaction is in progress.
+func (stack *UndoStack) CanRedo() bool {
+	return (len(stack.undone) > 0) && (stack.transaction == nil)
+}
+
+// Undo reverts the last command. Returns false if there is nothing to undo.
+func (stack *UndoStack) Undo() bool {
+	if !stack.CanUndo() {
+		return false
+	}
+	command := stack.done[len(stack.done)-1]
+	stack.done = stack.done[:len(stack.done)-1]
+	command.Undo()
+	stack.undone = append(stack.undone, command)
+	stack.lastPushFrame = -2
+	return true
+}
+
+// Redo applies the last undone command again. Returns false if there is nothing to redo.
+func (stack *UndoStack) Redo() bool {
+	if !stack.CanRedo() {
+		return false
+	}
+	command := stack.undone[len(stack.undone)-1]
+	stack.undone = stack.undone[:len(stack.undone)-1]
+	command.Redo()
+	stack.done = append(stack.done, command)
+	stack.lastPushFrame = -2
+	return true
+}
+
+// UndoName returns the name of the command that Undo() would revert, or an empty string.
+func (stack *UndoStack) UndoName() string {
+	if len(stack.done) == 0 {
+		return ""
+	}
+	return stack.done[len(stack.done)-1].Name()
+}
+
+// RedoName returns the name of the command that Redo() would apply, or an empty string.
+func (stack *UndoStack) RedoName() string {
+	if len(stack.undone) == 0 {
+		return ""
+	}
+	return stack.undone[len(stack.undone)-1].Name()
+}
+
+// History returns the names of all entries, oldest first, and the number of entries that are currently applied.
+// Entries from that index on have been undone.
+func (stack *UndoStack) History() (names []string, applied int) {
+	for _, command := range stack.done {
+		names = append(names, command.Name())
+	}
+	for index := len(stack.undone) - 1; index >= 0; index-- {
+		names = append(names, stack.undone[index].Name())
+	}
+	return names, len(stack.done)
+}
+
+// Clear removes all entries. A transaction in progress is discarded as well.
+func (stack *UndoStack) Clear() {
+	stack.done = nil
+	stack.undone = nil
+	stack.transaction = nil
+	stack.depth = 0
+	stack.lastPushFrame = -2
+	stack.edits = make(map[uintptr]reflect.Value)
+}
+
+// HandleShortcuts undoes with Ctrl+Z, and redoes with Ctrl+Y or Ctrl+Shift+Z.
+// The keys are ignored while text is entered, as text inputs handle them on their own.
+func (stack *UndoStack) HandleShortcuts() {
+	io := CurrentIO()
+	if !io.KeyCtrlPressed() || io.WantTextInput() {
+		return
+	}
+	if IsKeyPressed(KeyIndex(KeyZ)) {
+		if io.KeyShiftPressed() {
+			stack.Redo()
+		} else {
+			stack.Undo()
+		}
+	} else if IsKeyPressed(KeyIndex(KeyY)) {
+		stack.Redo()
+	}
+}
+
+// Edit calls widget, which edits the value that value points to, and records the edit.
+// The value is remembered when the widget is activated, and a ValueChange is pushed once the widget was
+// deactivated after an edit. Changes by widgets that are not active across frames, such as checkboxes,
+// are pushed immediately. Returns the result of widget, which should be the result of the wrapped widget.
+func (stack *UndoStack) Edit(name string, value interface{}, widget func() bool) bool {
+	target := reflect.ValueOf(value)
+	if (target.Kind() != reflect.Ptr) || target.IsNil() {
+		panic(fmt.Sprintf("imgui: undoable edit %q needs a non-nil pointer, got %T", name, value))
+	}
+	key := target.Pointer()
+	before := reflect.New(target.Type().Elem()).Elem()
+	before.Set(target.Elem())
+
+	changed := widget()
+	if IsItemActivated() {
+		stack.edits[key] = before
+	}
+	started, editing := stack.edits[key]
+	if !editing {
+		started = before
+	}
+	switch {
+	case changed && !IsItemActive():
+		delete(stack.edits, key)
+		stack.pushEdit(name, target, started)
+	case IsItemDeactivatedAfterEdit() && editing:
+		delete(stack.edits, key)
+		stack.pushEdit(name, target, started)
+	case IsItemDeactivated():
+		delete(stack.edits, key)
+	}
+	return changed
+}
+
+func (stack *UndoStack) pushEdit(name string, target reflect.Value, before reflect.Value) {
+	if reflect.DeepEqual(before.Interface(), target.Elem().Interface()) {
+		return
+	}
+	stack.Push(NewValueChange(name, target.Interface(), before.Interface(), target.Elem().Interface()))
+}
+
+// undoLabel returns the visible part of a widget label, to name its edits.
+func undoLabel(label string) string {
+	if visible := strings.SplitN(label, "##", 2)[0]; visible != "" {
+		return visible
+	}
+	return label
+}
+
+// Checkbox calls Checkbox() and records the change.
+func (stack *UndoStack) Checkbox(label string, selected *bool) bool {
+	return stack.Edit(undoLabel(label), selected, func() bool { return Checkbox(label, selected) })
+}
+
+// DragFloat calls DragFloat() and records the change.
+func (stack *UndoStack) DragFloat(label string, value *float32) bool {
+	return stack.Edit(undoLabel(label), value, func() bool { return DragFloat(label, value) })
+}
+
+// DragInt calls DragInt() and records the change.
+func (stack *UndoStack) DragInt(label string, value *int32) bool {
+	return stack.Edit(undoLabel(label), value, func() bool { return DragInt(label, value) })
+}
+
+// SliderFloat calls SliderFloat() and records the change.
+func (stack *UndoStack) SliderFloat(label string, value *float32, min, max float32) bool {
+	return stack.Edit(undoLabel(label), value, func() bool { return SliderFloat(label, value, min, max) })
+}
+
+// SliderInt calls SliderInt() and records the change.
+func (stack *UndoStack) SliderInt(label string, value *int32, min, max int32) bool {
+	return stack.Edit(undoLabel(label), value, func() bool { return SliderInt(label, value, min, max) })
+}
+
+// InputText calls InputText() and records the change once the input is deactivated.
+func (stack *UndoStack) InputText(label string, text *string) bool {
+	return stack.Edit(undoLabel(label), text, func() bool { return InputText(label, text) })
+}
+
+// InputInt calls InputInt() and records the change.
+func (stack *UndoStack) InputInt(label string, value *int32) bool {
+	return stack.Edit(undoLabel(label), value, func() bool { return InputInt(label, value) })
+}
+
+// InputFloat calls InputFloat() and records the change.
+func (stack *UndoStack) InputFloat(label string, value *float32) bool {
+	return stack.Edit(undoLabel(label), value, func() bool { return InputFloat(label, value) })
+}
+
+// ColorEdit3 calls ColorEdit3() and records the change.
+func (stack *UndoStack) ColorEdit3(label string, col *[3]float32) bool {
+	return stack.Edit(undoLabel(label), col, func() bool { return ColorEdit3(label, col) })
+}
+
+// ColorEdit4 calls ColorEdit4() and records the change.
+func (stack *UndoStack) ColorEdit4(label string, col *[4]float32) bool {
+	return stack.Edit(undoLabel(label), col, func() bool { return ColorEdit4(label, col) })
+}
+
+// RenderHistory displays buttons to undo and redo, and the list of entries.
+// Clicking an entry undoes or redoes all entries up to and including it; the first line reverts all of them.
+func (stack *UndoStack) RenderHistory() {
+	undoAvailable, redoAvailable := stack.CanUndo(), stack.CanRedo()
+	if !undoAvailable {
+		PushStyleColor(StyleColorText, CurrentStyle().Color(StyleColorTextDisabled))
+	}
+	if Button("Undo") && undoAvailable {
+		stack.Undo()
+	}
+	if !undoAvailable {
+		PopStyleColor()
+	}
+	SameLine()
+	if !redoAvailable {
+		PushStyleColor(StyleColorText, CurrentStyle().Color(StyleColorTextDisabled))
+	}
+	if Button("Redo") && redoAvailable {
+		stack.Redo()
+	}
+	if !redoAvailable {
+		PopStyleColor()
+	}
+
+	names, applied := stack.History()
+	BeginChildV("##history", Vec2{}, true, 0)
+	target := -1
+	if SelectableV("<Initial state>", applied == 0, 0, Vec2{}) {
+		target = 0
+	}
+	for index, name := range names {
+		if index >= applied {
+			PushStyleColor(StyleColorText, CurrentStyle().Color(StyleColorTextDisabled))
+		}
+		if SelectableV(fmt.Sprintf("%s##%d", name, index), index == applied-1, 0, Vec2{}) {
+			target = index + 1
+		}
+		if index >= applied {
+			PopStyleColor()
+		}
+	}
+	EndChild()
+
+	for (target >= 0) && (target < applied) && stack.Undo() {
+		applied--
+	}
+	for (target > applied) && stack.Redo() {
+		applied++
+	}
+}
@@ -0,0 +1,133 @@
+package imgui_test
+
+import (
+	"testing"
+
+	"github.com/ianling/imgui-go"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUndoStackCommands(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 400, Y: 300})
+	defer context.Destroy()
+
+	value := 1
+	history := imgui.NewUndoStack()
+	history.Do(imgui.NewValueChange("Set 2", &value, value, 2))
+	renderTestWindow(func() {})
+	renderTestWindow(func() {})
+	history.Do(imgui.NewValueChange("Set 3", &value, value, 3))
+	assert.Equal(t, 3, value)
+	assert.Equal(t, "Set 3", history.UndoName())
+
+	require.True(t, history.Undo())
+	assert.Equal(t, 2, value)
+	assert.Equal(t, "Set 3", history.RedoName())
+	require.True(t, history.Undo())
+	assert.Equal(t, 1, value)
+	assert.False(t, history.Undo(), "Nothing should be left to undo")
+	require.True(t, history.Redo())
+	assert.Equal(t, 2, value)
+
+	other := "a"
+	history.BeginTransaction("Both")
+	history.Do(imgui.NewValueChange("Value", &value, value, 5))
+	history.BeginTransaction("Nested")
+	history.Do(imgui.NewValueChange("Other", &other, other, "b"))
+	history.EndTransaction()
+	assert.False(t, history.CanUndo(), "Undo should not be possible during a transaction")
+	history.EndTransaction()
+	names, applied := history.History()
+	assert.Equal(t, []string{"Set 2", "Both"}, names, "Transaction should replace undone entries")
+	assert.Equal(t, 2, applied)
+
+	require.True(t, history.Undo())
+	assert.Equal(t, 2, value)
+	assert.Equal(t, "a", other)
+
+	history.Limit = 1
+	history.Do(imgui.NewValueChange("Set 6", &value, value, 6))
+	names, _ = history.History()
+	assert.Equal(t, []string{"Set 6"}, names, "Limit should drop oldest entries")
+}
+
+func TestUndoStackMergesContinuousChanges(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 400, Y: 300})
+	defer context.Destroy()
+
+	var position float32
+	history := imgui.NewUndoStack()
+	for frame := 1; frame <= 5; frame++ {
+		renderTestWindow(func() {
+			history.Do(imgui.NewValueChange("Move", &position, position, float32(frame)))
+		})
+	}
+	renderTestWindow(func() {})
+	renderTestWindow(func() {
+		history.Do(imgui.NewValueChange("Move", &position, position, float32(10)))
+	})
+	names, _ := history.History()
+	assert.Equal(t, []string{"Move", "Move"}, names, "Only changes in consecutive frames should be merged")
+	history.Undo()
+	assert.Equal(t, float32(5), position)
+	history.Undo()
+	assert.Equal(t, float32(0), position)
+}
+
+func TestUndoStackWidgets(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 400, Y: 300})
+	defer context.Destroy()
+	io := imgui.CurrentIO()
+
+	var speed float32
+	visible := false
+	history := imgui.NewUndoStack()
+	render := func() {
+		renderTestWindow(func() {
+			history.DragFloat("Speed##drag", &speed)
+			history.Checkbox("Visible", &visible)
+			history.RenderHistory()
+		})
+	}
+	render()
+
+	io.SetMousePosition(imgui.Vec2{X: 100, Y: 15})
+	io.SetMouseButtonDown(0, true)
+	render()
+	for step := 1; step <= 5; step++ {
+		io.SetMousePosition(imgui.Vec2{X: 100 + float32(step*10), Y: 15})
+		render()
+	}
+	io.SetMouseButtonDown(0, false)
+	render()
+	render()
+	require.True(t, speed > 0, "Drag should change the value")
+
+	io.SetMousePosition(imgui.Vec2{X: 15, Y: 38})
+	for _, down := range []bool{true, false, false} {
+		io.SetMouseButtonDown(0, down)
+		render()
+	}
+	require.True(t, visible, "Click should toggle the checkbox")
+
+	names, applied := history.History()
+	assert.Equal(t, []string{"Speed", "Visible"}, names, "Drag should be recorded as one entry")
+	assert.Equal(t, 2, applied)
+
+	const keyCtrl, keyZ = 300, 301
+	io.KeyMap(imgui.KeyZ, keyZ)
+	io.KeyPress(keyCtrl)
+	io.KeyCtrl(keyCtrl, keyCtrl)
+	for _, down := range []bool{true, false, true, false} {
+		if down {
+			io.KeyPress(keyZ)
+		} else {
+			io.KeyRelease(keyZ)
+		}
+		renderTestFrame(history.HandleShortcuts)
+	}
+	assert.False(t, visible)
+	assert.Equal(t, float32(0), speed, "Ctrl+Z should undo twice")
+}
@@ -0,0 +1,8 @@
+// +build !imguiwchar32
+
+package imgui
+
+// UnicodeCodepointMax is the maximum Unicode code point supported by this build.
+// Characters are stored in 16 bits; build with the imguiwchar32 tag to support code points above U+FFFF,
+// such as emoji.
+const UnicodeCodepointMax = 0xFFFF
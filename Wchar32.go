@@ -0,0 +1,13 @@
+// +build imguiwchar32
+
+package imgui
+
+// #cgo CXXFLAGS: -DIMGUI_USE_WCHAR32
+// #cgo CFLAGS: -DIMGUI_USE_WCHAR32
+// #cgo CPPFLAGS: -DIMGUI_USE_WCHAR32
+import "C"
+
+// UnicodeCodepointMax is the maximum Unicode code point supported by this build.
+// Characters are stored in 32 bits, as this build was made with the imguiwchar32 tag.
+// This allows to load glyphs and enter characters outside of the basic multilingual plane, such as emoji.
+const UnicodeCodepointMax = 0x10FFFF
@@ -8,7 +8,7 @@ import "math"
 // Text adds formatted text. See PushTextWrapPosV() or PushStyleColorV() for modifying the output.
 // Without any modified style stack, the text is unformatted.
 func Text(text string) {
-	textArg, textFin := wrapString(text)
+	textArg, textFin := wrapString(submittedText(breakWrappedLines(text)))
 	defer textFin()
 	// Internally we use ImGui::TextUnformatted, for the most direct call.
 	C.iggTextUnformatted(textArg)
@@ -16,16 +16,16 @@ func Text(text string) {
 
 // LabelText adds text+label aligned the same way as value+label widgets.
 func LabelText(label, text string) {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
-	textArg, textFin := wrapString(text)
+	textArg, textFin := wrapString(submittedText(text))
 	defer textFin()
 	C.iggLabelText(labelArg, textArg)
 }
 
 // ButtonV returns true if it is clicked.
 func ButtonV(id string, size Vec2) bool {
-	idArg, idFin := wrapString(id)
+	idArg, idFin := wrapString(submittedLabel(id))
 	defer idFin()
 	sizeArg, _ := size.wrapped()
 	return C.iggButton(idArg, sizeArg) != 0
@@ -51,11 +51,23 @@ const (
 )
 
 func SmallButton(id string) bool {
-	idArg, idFin := wrapString(id)
+	idArg, idFin := wrapString(submittedLabel(id))
 	defer idFin()
 	return C.iggSmallButton(idArg) != 0
 }
 
+// Directions of ArrowButton(), the values of ImGuiDir.
+const (
+	// DirLeft points to the left.
+	DirLeft uint8 = 0
+	// DirRight points to the right.
+	DirRight uint8 = 1
+	// DirUp points upwards.
+	DirUp uint8 = 2
+	// DirDown points downwards.
+	DirDown uint8 = 3
+)
+
 func ArrowButton(id string, dir uint8) bool {
 	idArg, idFin := wrapString(id)
 	defer idFin()
@@ -65,7 +77,7 @@ func ArrowButton(id string, dir uint8) bool {
 // BulletText.
 // Text with a little bullet aligned to the typical tree node.
 func BulletText(text string) {
-	textArg, textFin := wrapString(text)
+	textArg, textFin := wrapString(submittedText(text))
 	defer textFin()
 	C.iggBulletText(textArg)
 }
@@ -119,7 +131,7 @@ func ImageButton(id TextureID, size Vec2) bool {
 // Checkbox creates a checkbox in the selected state.
 // The return value indicates if the selected state has changed.
 func Checkbox(id string, selected *bool) bool {
-	idArg, idFin := wrapString(id)
+	idArg, idFin := wrapString(submittedLabel(id))
 	defer idFin()
 	selectedArg, selectedFin := wrapBool(selected)
 	defer selectedFin()
@@ -128,7 +140,7 @@ func Checkbox(id string, selected *bool) bool {
 
 // RadioButton returns true if it is clicked and active indicates if it is selected.
 func RadioButton(id string, active bool) bool {
-	idArg, idFin := wrapString(id)
+	idArg, idFin := wrapString(submittedLabel(id))
 	defer idFin()
 	return C.iggRadioButton(idArg, castBool(active)) != 0
 }
@@ -144,7 +156,7 @@ func RadioButton(id string, active bool) bool {
 //		imgui.RadioButtonInt("radio c", &v, 2)
 //
 func RadioButtonInt(id string, v *int, button int) bool {
-	idArg, idFin := wrapString(id)
+	idArg, idFin := wrapString(submittedLabel(id))
 	defer idFin()
 	ok := C.iggRadioButton(idArg, castBool(button == *v)) != 0
 	if ok {
@@ -163,7 +175,7 @@ func Bullet() {
 // size (for each axis) is < 0.0f: align to end, 0.0f: auto, > 0.0f: specified size.
 func ProgressBarV(fraction float32, size Vec2, overlay string) {
 	sizeArg, _ := size.wrapped()
-	overlayArg, overlayFin := wrapString(overlay)
+	overlayArg, overlayFin := wrapString(submittedLabel(overlay))
 	defer overlayFin()
 	C.iggProgressBar(C.float(fraction), sizeArg, overlayArg)
 }
@@ -200,9 +212,9 @@ const (
 // Call EndCombo() if this function returns true.
 // flags are the ComboFlags to apply.
 func BeginComboV(label, previewValue string, flags ComboFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
-	previewValueArg, previewValueFin := wrapString(previewValue)
+	previewValueArg, previewValueFin := wrapString(submittedLabel(previewValue))
 	defer previewValueFin()
 	return C.iggBeginCombo(labelArg, previewValueArg, C.int(flags)) != 0
 }
@@ -236,7 +248,7 @@ const (
 
 // DragFloatV creates a draggable slider for floats.
 func DragFloatV(label string, value *float32, speed, min, max float32, format string, flags SliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	valueArg, valueFin := wrapFloat(value)
 	defer valueFin()
@@ -252,7 +264,7 @@ func DragFloat(label string, value *float32) bool {
 
 // DragFloat2V creates a draggable slider for a 2D vector.
 func DragFloat2V(label string, values *[2]float32, speed, min, max float32, format string, flags SliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
@@ -267,7 +279,7 @@ func DragFloat2(label string, value *[2]float32) bool {
 
 // DragFloat3V creates a draggable slider for a 3D vector.
 func DragFloat3V(label string, values *[3]float32, speed, min, max float32, format string, flags SliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
@@ -282,7 +294,7 @@ func DragFloat3(label string, value *[3]float32) bool {
 
 // DragFloat4V creates a draggable slider for a 4D vector.
 func DragFloat4V(label string, values *[4]float32, speed, min, max float32, format string, flags SliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
@@ -297,7 +309,7 @@ func DragFloat4(label string, value *[4]float32) bool {
 
 // DragFloatRange2V creates a draggable slider in floats range.
 func DragFloatRange2V(label string, currentMin *float32, currentMax *float32, speed float32, min float32, max float32, format string, formatMax string, flags SliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	currentMinArg, currentMinFin := wrapFloat(currentMin)
 	defer currentMinFin()
@@ -317,7 +329,7 @@ func DragFloatRange2(label string, currentMin *float32, currentMax *float32) boo
 
 // DragIntV creates a draggable slider for integers.
 func DragIntV(label string, value *int32, speed float32, min, max int32, format string, flags SliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	valueArg, valueFin := wrapInt32(value)
 	defer valueFin()
@@ -333,7 +345,7 @@ func DragInt(label string, value *int32) bool {
 
 // DragInt2V creates a draggable slider for a 2D vector.
 func DragInt2V(label string, values *[2]int32, speed float32, min, max int32, format string, flags SliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
@@ -348,7 +360,7 @@ func DragInt2(label string, value *[2]int32) bool {
 
 // DragInt3V creates a draggable slider for a 3D vector.
 func DragInt3V(label string, values *[3]int32, speed float32, min, max int32, format string, flags SliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
@@ -363,7 +375,7 @@ func DragInt3(label string, value *[3]int32) bool {
 
 // DragInt4V creates a draggable slider for a 4D vector.
 func DragInt4V(label string, values *[4]int32, speed float32, min, max int32, format string, flags SliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
@@ -378,7 +390,7 @@ func DragInt4(label string, value *[4]int32) bool {
 
 // DragIntRange2V creates a draggable slider in ints range.
 func DragIntRange2V(label string, currentMin *int32, currentMax *int32, speed float32, min int, max int, format string, formatMax string, flags SliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	currentMinArg, currentMinFin := wrapInt32(currentMin)
 	defer currentMinFin()
@@ -398,7 +410,7 @@ func DragIntRange2(label string, currentMin *int32, currentMax *int32) bool {
 
 // SliderFloatV creates a slider for floats.
 func SliderFloatV(label string, value *float32, min, max float32, format string, flags SliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	valueArg, valueFin := wrapFloat(value)
 	defer valueFin()
@@ -414,7 +426,7 @@ func SliderFloat(label string, value *float32, min, max float32) bool {
 
 // SliderFloat2V creates slider for a 2D vector.
 func SliderFloat2V(label string, values *[2]float32, min, max float32, format string, flags SliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
@@ -429,7 +441,7 @@ func SliderFloat2(label string, values *[2]float32, min, max float32) bool {
 
 // SliderFloat3V creates slider for a 3D vector.
 func SliderFloat3V(label string, values *[3]float32, min, max float32, format string, flags SliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
@@ -444,7 +456,7 @@ func SliderFloat3(label string, values *[3]float32, min, max float32) bool {
 
 // SliderFloat4V creates slider for a 4D vector.
 func SliderFloat4V(label string, values *[4]float32, min, max float32, format string, flags SliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
@@ -459,7 +471,7 @@ func SliderFloat4(label string, values *[4]float32, min, max float32) bool {
 
 // SliderIntV creates a slider for integers.
 func SliderIntV(label string, value *int32, min, max int32, format string, flags SliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	valueArg, valueFin := wrapInt32(value)
 	defer valueFin()
@@ -475,7 +487,7 @@ func SliderInt(label string, value *int32, min, max int32) bool {
 
 // SliderInt2V creates slider for a 2D vector.
 func SliderInt2V(label string, values *[2]int32, min, max int, format string, flags SliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
@@ -490,7 +502,7 @@ func SliderInt2(label string, values *[2]int32, min, max int) bool {
 
 // SliderInt3V creates slider for a 3D vector.
 func SliderInt3V(label string, values *[3]int32, min, max int, format string, flags SliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
@@ -505,7 +517,7 @@ func SliderInt3(label string, values *[3]int32, min, max int) bool {
 
 // SliderInt4V creates slider for a 4D vector.
 func SliderInt4V(label string, values *[4]int32, min, max int, format string, flags SliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
@@ -521,7 +533,7 @@ func SliderInt4(label string, values *[4]int32, min, max int) bool {
 // VSliderFloatV creates a vertically oriented slider for floats.
 func VSliderFloatV(label string, size Vec2, value *float32, min, max float32, format string, flags SliderFlags) bool {
 	sizeArg, _ := size.wrapped()
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	valueArg, valueFin := wrapFloat(value)
 	defer valueFin()
@@ -538,7 +550,7 @@ func VSliderFloat(label string, size Vec2, value *float32, min, max float32) boo
 // VSliderIntV creates a vertically oriented slider for integers.
 func VSliderIntV(label string, size Vec2, value *int32, min, max int32, format string, flags SliderFlags) bool {
 	sizeArg, _ := size.wrapped()
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	valueArg, valueFin := wrapInt32(value)
 	defer valueFin()
@@ -644,12 +656,12 @@ func inputTextSingleline(label string, hint *string, text *string, flags InputTe
 	if text == nil {
 		panic("text can't be nil")
 	}
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	var hintArg *C.char
 	var hintFin func()
 	if hint != nil {
-		hintArg, hintFin = wrapString(*hint)
+		hintArg, hintFin = wrapString(submittedText(*hint))
 		defer hintFin()
 	}
 	state := newInputTextState(*text, cb)
@@ -674,7 +686,7 @@ func InputTextMultilineV(label string, text *string, size Vec2, flags InputTextF
 	if text == nil {
 		panic("text can't be nil")
 	}
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	sizeArg, _ := size.wrapped()
 	state := newInputTextState(*text, cb)
@@ -694,7 +706,7 @@ func InputTextMultiline(label string, text *string) bool {
 
 // InputIntV creates a input field for integer type.
 func InputIntV(label string, value *int32, step int, stepFast int, flags InputTextFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	valueArg, valueFin := wrapInt32(value)
 	defer valueFin()
@@ -712,7 +724,7 @@ func InputFloat(label string, value *float32) bool {
 }
 
 func InputFloatV(label string, value *float32, step, step_fast float32, format string, flags int) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	valueArg, valueFin := wrapFloat(value)
 	defer valueFin()
@@ -790,7 +802,7 @@ func ColorEdit3(label string, col *[3]float32) bool {
 
 // ColorEdit3V will show a clickable little square which will open a color picker window for 3D vector (rgb format).
 func ColorEdit3V(label string, col *[3]float32, flags ColorEditFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	ccol := (*C.float)(&col[0])
 	return C.iggColorEdit3(labelArg, ccol, C.int(flags)) != 0
@@ -803,7 +815,7 @@ func ColorEdit4(label string, col *[4]float32) bool {
 
 // ColorEdit4V will show a clickable little square which will open a color picker window for 4D vector (rgba format).
 func ColorEdit4V(label string, col *[4]float32, flags ColorEditFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	ccol := (*C.float)(&col[0])
 	return C.iggColorEdit4(labelArg, ccol, C.int(flags)) != 0
@@ -869,7 +881,7 @@ func ColorPicker3(label string, col *[3]float32) bool {
 
 // ColorPicker3V will show directly a color picker control for editing a color in 3D vector (rgb format).
 func ColorPicker3V(label string, col *[3]float32, flags ColorPickerFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	ccol := (*C.float)(&col[0])
 	return C.iggColorPicker3(labelArg, ccol, C.int(flags)) != 0
@@ -882,7 +894,7 @@ func ColorPicker4(label string, col *[4]float32) bool {
 
 // ColorPicker4V will show directly a color picker control for editing a color in 4D vector (rgba format).
 func ColorPicker4V(label string, col *[4]float32, flags ColorPickerFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	ccol := (*C.float)(&col[0])
 	return C.iggColorPicker4(labelArg, ccol, C.int(flags)) != 0
@@ -895,7 +907,7 @@ func CollapsingHeader(label string) bool {
 
 // CollapsingHeaderV adds a collapsing header with TreeNode flags.
 func CollapsingHeaderV(label string, flags TreeNodeFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	return C.iggCollapsingHeader(labelArg, C.int(flags)) != 0
 }
@@ -948,7 +960,7 @@ const (
 
 // TreeNodeV returns true if the tree branch is to be rendered. Call TreePop() in this case.
 func TreeNodeV(label string, flags TreeNodeFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	return C.iggTreeNode(labelArg, C.int(flags)) != 0
 }
@@ -996,7 +1008,7 @@ const (
 // size.x==0.0: use remaining width, size.x>0.0: specify width.
 // size.y==0.0: use label height, size.y>0.0: specify height.
 func SelectableV(label string, selected bool, flags SelectableFlags, size Vec2) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	sizeArg, _ := size.wrapped()
 	return C.iggSelectable(labelArg, castBool(selected), C.int(flags), sizeArg) != 0
@@ -1014,7 +1026,7 @@ func Selectable(label string) bool {
 // - Choose frame width:   size.x > 0.0f: custom  /  size.x < 0.0f or -FLT_MIN: right-align   /  size.x = 0.0f (default): use current ItemWidth
 // - Choose frame height:  size.y > 0.0f: custom  /  size.y < 0.0f or -FLT_MIN: bottom-align  /  size.y = 0.0f (default): arbitrary default height which can fit ~7 items.
 func BeginListBoxV(label string, size Vec2) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	sizeArg, _ := size.wrapped()
 	return C.iggBeginListBox(labelArg, sizeArg) != 0
@@ -1035,7 +1047,7 @@ func EndListBox() {
 // This version accepts a custom item height.
 // The function returns true if the selection was changed. The value of currentItem will indicate the new selected item.
 func ListBoxV(label string, currentItem *int32, items []string, heightItems int) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 
 	valueArg, valueFin := wrapInt32(currentItem)
@@ -1051,7 +1063,7 @@ func ListBoxV(label string, currentItem *int32, items []string, heightItems int)
 		}
 	}()
 	for i, item := range items {
-		itemArg, itemFin := wrapString(item)
+		itemArg, itemFin := wrapString(submittedLabel(item))
 		itemFins = append(itemFins, itemFin)
 		argv[i] = itemArg
 	}
@@ -1077,7 +1089,7 @@ func PlotLines(label string, values []float32) {
 // scaleMin and scaleMax define the scale of the y axis, if either is math.MaxFloat32 that value is calculated from the input data.
 // graphSize defines the size of the graph, if either coordinate is zero the default size for that direction is used.
 func PlotLinesV(label string, values []float32, valuesOffset int, overlayText string, scaleMin float32, scaleMax float32, graphSize Vec2) {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 
 	valuesCount := len(values)
@@ -1089,7 +1101,7 @@ func PlotLinesV(label string, values []float32, valuesOffset int, overlayText st
 	var overlayTextArg *C.char
 	if overlayText != "" {
 		var overlayTextFinisher func()
-		overlayTextArg, overlayTextFinisher = wrapString(overlayText)
+		overlayTextArg, overlayTextFinisher = wrapString(submittedLabel(overlayText))
 		defer overlayTextFinisher()
 	}
 
@@ -1110,7 +1122,7 @@ func PlotHistogram(label string, values []float32) {
 // scaleMin and scaleMax define the scale of the y axis, if either is math.MaxFloat32 that value is calculated from the input data.
 // graphSize defines the size of the graph, if either coordinate is zero the default size for that direction is used.
 func PlotHistogramV(label string, values []float32, valuesOffset int, overlayText string, scaleMin float32, scaleMax float32, graphSize Vec2) {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 
 	valuesCount := len(values)
@@ -1122,7 +1134,7 @@ func PlotHistogramV(label string, values []float32, valuesOffset int, overlayTex
 	var overlayTextArg *C.char
 	if overlayText != "" {
 		var overlayTextFinisher func()
-		overlayTextArg, overlayTextFinisher = wrapString(overlayText)
+		overlayTextArg, overlayTextFinisher = wrapString(submittedLabel(overlayText))
 		defer overlayTextFinisher()
 	}
 
@@ -1134,7 +1146,7 @@ func PlotHistogramV(label string, values []float32, valuesOffset int, overlayTex
 // SetTooltip sets a text tooltip under the mouse-cursor, typically use with IsItemHovered().
 // Overrides any previous call to SetTooltip().
 func SetTooltip(text string) {
-	textArg, textFin := wrapString(text)
+	textArg, textFin := wrapString(submittedText(text))
 	defer textFin()
 	C.iggSetTooltip(textArg)
 }
@@ -1178,7 +1190,7 @@ func EndMenuBar() {
 // BeginMenuV creates a sub-menu entry.
 // If the return value is true, then EndMenu() must be called!
 func BeginMenuV(label string, enabled bool) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	return C.iggBeginMenu(labelArg, castBool(enabled)) != 0
 }
@@ -1199,9 +1211,9 @@ func EndMenu() {
 // If selected is not nil, it will be toggled when true is returned.
 // Shortcuts are displayed for convenience but not processed by ImGui at the moment.
 func MenuItemV(label string, shortcut string, selected bool, enabled bool) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
-	shortcutArg, shortcutFin := wrapString(shortcut)
+	shortcutArg, shortcutFin := wrapString(submittedText(shortcut))
 	defer shortcutFin()
 	return C.iggMenuItem(labelArg, shortcutArg, castBool(selected), castBool(enabled)) != 0
 }
@@ -1353,7 +1365,7 @@ const (
 
 // BeginTabItemV create a Tab. Returns true if the Tab is selected.
 func BeginTabItemV(label string, open *bool, flags TabItemFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 
 	openArg, openFin := wrapBool(open)
@@ -1375,7 +1387,7 @@ func EndTabItem() {
 
 // TabItemButtonV create a Tab behaving like a button. return true when clicked. cannot be selected in the tab bar.
 func TabItemButtonV(label string, flags TabItemFlags) bool {
-	labelArg, labelFin := wrapString(label)
+	labelArg, labelFin := wrapString(submittedLabel(label))
 	defer labelFin()
 	return C.iggTabItemButton(labelArg, C.int(flags)) != 0
 }
@@ -1389,7 +1401,7 @@ func TabItemButton(label string) bool {
 // (useful to reduce visual flicker on reorderable tab bars). For tab-bar: call
 // after BeginTabBar() and before Tab submissions. Otherwise call with a window name.
 func SetTabItemClosed(tabOrDockedWindowLabel string) {
-	labelArg, labelFin := wrapString(tabOrDockedWindowLabel)
+	labelArg, labelFin := wrapString(shapedLabel(tabOrDockedWindowLabel))
 	defer labelFin()
 	C.iggSetTabItemClosed(labelArg)
 }
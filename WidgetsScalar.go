@@ -0,0 +1,955 @@
+package imgui
+
+// #include "wrapper/Widgets.h"
+import "C"
+
+import (
+	"math"
+	"unsafe"
+)
+
+// DataType identifies the type of the values of the scalar widgets, such as DragScalarNV().
+type DataType int
+
+// This is the list of data types, matching the Go types of the same name.
+const (
+	DataTypeInt8    DataType = 0
+	DataTypeUint8   DataType = 1
+	DataTypeInt16   DataType = 2
+	DataTypeUint16  DataType = 3
+	DataTypeInt32   DataType = 4
+	DataTypeUint32  DataType = 5
+	DataTypeInt64   DataType = 6
+	DataTypeUint64  DataType = 7
+	DataTypeFloat32 DataType = 8
+	DataTypeFloat64 DataType = 9
+)
+
+// wrapFormat wraps the format of a scalar widget. An empty format selects the default format of the data type.
+func wrapFormat(format string) (wrapped *C.char, finisher func()) {
+	if format == "" {
+		return nil, func() {}
+	}
+	return wrapString(format)
+}
+
+// DragScalarNV creates draggable sliders for a number of components of given data type.
+// data points to the first component; min and max point to single values of the data type, or are nil.
+// A min that is not less than max does not clamp the values. An empty format uses the default of the data type.
+// The typed variants, such as DragInt64V() and DragFloat64SliceV(), are easier to use.
+func DragScalarNV(label string, dataType DataType, data unsafe.Pointer, components int, speed float32,
+	min, max unsafe.Pointer, format string, flags SliderFlags) bool {
+	labelArg, labelFin := wrapString(submittedLabel(label))
+	defer labelFin()
+	formatArg, formatFin := wrapFormat(format)
+	defer formatFin()
+	return C.iggDragScalarN(labelArg, C.int(dataType), data, C.int(components), C.float(speed), min, max,
+		formatArg, C.int(flags)) != 0
+}
+
+// DragScalarN calls DragScalarNV(label, dataType, data, components, 1.0, nil, nil, "", SliderFlagsNone).
+func DragScalarN(label string, dataType DataType, data unsafe.Pointer, components int) bool {
+	return DragScalarNV(label, dataType, data, components, 1.0, nil, nil, "", SliderFlagsNone)
+}
+
+// SliderScalarNV creates sliders for a number of components of given data type.
+// data points to the first component; min and max point to single values of the data type and must not be nil.
+// Sliders support only half the range of their data type, such as math.MinInt64/2 to math.MaxInt64/2 for int64,
+// or 0 to math.MaxUint32/2 for uint32. min and max are clamped to this range.
+// An empty format uses the default of the data type.
+// The typed variants, such as SliderInt64V() and SliderFloat64SliceV(), are easier to use.
+func SliderScalarNV(label string, dataType DataType, data unsafe.Pointer, components int,
+	min, max unsafe.Pointer, format string, flags SliderFlags) bool {
+	if (min == nil) || (max == nil) {
+		panic("min and max can't be nil")
+	}
+	min, max = sliderRange(dataType, min, max)
+	labelArg, labelFin := wrapString(submittedLabel(label))
+	defer labelFin()
+	formatArg, formatFin := wrapFormat(format)
+	defer formatFin()
+	return C.iggSliderScalarN(labelArg, C.int(dataType), data, C.int(components), min, max,
+		formatArg, C.int(flags)) != 0
+}
+
+// SliderScalarN calls SliderScalarNV(label, dataType, data, components, min, max, "", SliderFlagsNone).
+func SliderScalarN(label string, dataType DataType, data unsafe.Pointer, components int, min, max unsafe.Pointer) bool {
+	return SliderScalarNV(label, dataType, data, components, min, max, "", SliderFlagsNone)
+}
+
+// sliderRange returns copies of the values min and max point to, clamped to the range imgui supports for
+// sliders of the data type. Types of less than 32 bits are not limited.
+func sliderRange(dataType DataType, min, max unsafe.Pointer) (unsafe.Pointer, unsafe.Pointer) {
+	switch dataType {
+	case DataTypeInt32:
+		limits := [2]int32{*(*int32)(min), *(*int32)(max)}
+		for index, value := range limits {
+			if value < math.MinInt32/2 {
+				limits[index] = math.MinInt32 / 2
+			} else if value > math.MaxInt32/2 {
+				limits[index] = math.MaxInt32 / 2
+			}
+		}
+		return unsafe.Pointer(&limits[0]), unsafe.Pointer(&limits[1])
+	case DataTypeUint32:
+		limits := [2]uint32{*(*uint32)(min), *(*uint32)(max)}
+		for index, value := range limits {
+			if value > math.MaxUint32/2 {
+				limits[index] = math.MaxUint32 / 2
+			}
+		}
+		return unsafe.Pointer(&limits[0]), unsafe.Pointer(&limits[1])
+	case DataTypeInt64:
+		limits := [2]int64{*(*int64)(min), *(*int64)(max)}
+		for index, value := range limits {
+			if value < math.MinInt64/2 {
+				limits[index] = math.MinInt64 / 2
+			} else if value > math.MaxInt64/2 {
+				limits[index] = math.MaxInt64 / 2
+			}
+		}
+		return unsafe.Pointer(&limits[0]), unsafe.Pointer(&limits[1])
+	case DataTypeUint64:
+		limits := [2]uint64{*(*uint64)(min), *(*uint64)(max)}
+		for index, value := range limits {
+			if value > math.MaxUint64/2 {
+				limits[index] = math.MaxUint64 / 2
+			}
+		}
+		return unsafe.Pointer(&limits[0]), unsafe.Pointer(&limits[1])
+	case DataTypeFloat32:
+		limits := [2]float32{*(*float32)(min), *(*float32)(max)}
+		for index, value := range limits {
+			if value < -math.MaxFloat32/2 {
+				limits[index] = -math.MaxFloat32 / 2
+			} else if value > math.MaxFloat32/2 {
+				limits[index] = math.MaxFloat32 / 2
+			}
+		}
+		return unsafe.Pointer(&limits[0]), unsafe.Pointer(&limits[1])
+	case DataTypeFloat64:
+		limits := [2]float64{*(*float64)(min), *(*float64)(max)}
+		for index, value := range limits {
+			if value < -math.MaxFloat64/2 {
+				limits[index] = -math.MaxFloat64 / 2
+			} else if value > math.MaxFloat64/2 {
+				limits[index] = math.MaxFloat64 / 2
+			}
+		}
+		return unsafe.Pointer(&limits[0]), unsafe.Pointer(&limits[1])
+	default:
+		return min, max
+	}
+}
+
+// InputScalarNV creates input fields for a number of components of given data type.
+// data points to the first component; step and stepFast point to single values of the data type, or are nil.
+// Step buttons are shown if step is not nil. An empty format uses the default of the data type.
+// The typed variants, such as InputInt64V() and InputFloat64SliceV(), are easier to use.
+func InputScalarNV(label string, dataType DataType, data unsafe.Pointer, components int,
+	step, stepFast unsafe.Pointer, format string, flags InputTextFlags) bool {
+	labelArg, labelFin := wrapString(submittedLabel(label))
+	defer labelFin()
+	formatArg, formatFin := wrapFormat(format)
+	defer formatFin()
+	return C.iggInputScalarN(labelArg, C.int(dataType), data, C.int(components), step, stepFast,
+		formatArg, C.int(flags)) != 0
+}
+
+// InputScalarN calls InputScalarNV(label, dataType, data, components, nil, nil, "", 0).
+func InputScalarN(label string, dataType DataType, data unsafe.Pointer, components int) bool {
+	return InputScalarNV(label, dataType, data, components, nil, nil, "", 0)
+}
+
+// DragInt8V creates a draggable slider for an int8 value. A min that is not less than max does not clamp the value.
+func DragInt8V(label string, value *int8, speed float32, min, max int8, format string, flags SliderFlags) bool {
+	return DragScalarNV(label, DataTypeInt8, unsafe.Pointer(value), 1, speed, unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// DragInt8 calls DragInt8V(label, value, 1.0, 0, 0, "", SliderFlagsNone).
+func DragInt8(label string, value *int8) bool {
+	return DragInt8V(label, value, 1.0, 0, 0, "", SliderFlagsNone)
+}
+
+// DragInt8SliceV creates draggable sliders for all values of the slice, in one line.
+// A min that is not less than max does not clamp the values.
+func DragInt8SliceV(label string, values []int8, speed float32, min, max int8, format string, flags SliderFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	return DragScalarNV(label, DataTypeInt8, unsafe.Pointer(&values[0]), len(values), speed,
+		unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// DragInt8Slice calls DragInt8SliceV(label, values, 1.0, 0, 0, "", SliderFlagsNone).
+func DragInt8Slice(label string, values []int8) bool {
+	return DragInt8SliceV(label, values, 1.0, 0, 0, "", SliderFlagsNone)
+}
+
+// DragUint8V creates a draggable slider for a uint8 value. A min that is not less than max does not clamp the value.
+func DragUint8V(label string, value *uint8, speed float32, min, max uint8, format string, flags SliderFlags) bool {
+	return DragScalarNV(label, DataTypeUint8, unsafe.Pointer(value), 1, speed, unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// DragUint8 calls DragUint8V(label, value, 1.0, 0, 0, "", SliderFlagsNone).
+func DragUint8(label string, value *uint8) bool {
+	return DragUint8V(label, value, 1.0, 0, 0, "", SliderFlagsNone)
+}
+
+// DragUint8SliceV creates draggable sliders for all values of the slice, in one line.
+// A min that is not less than max does not clamp the values.
+func DragUint8SliceV(label string, values []uint8, speed float32, min, max uint8, format string, flags SliderFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	return DragScalarNV(label, DataTypeUint8, unsafe.Pointer(&values[0]), len(values), speed,
+		unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// DragUint8Slice calls DragUint8SliceV(label, values, 1.0, 0, 0, "", SliderFlagsNone).
+func DragUint8Slice(label string, values []uint8) bool {
+	return DragUint8SliceV(label, values, 1.0, 0, 0, "", SliderFlagsNone)
+}
+
+// DragInt16V creates a draggable slider for an int16 value. A min that is not less than max does not clamp the value.
+func DragInt16V(label string, value *int16, speed float32, min, max int16, format string, flags SliderFlags) bool {
+	return DragScalarNV(label, DataTypeInt16, unsafe.Pointer(value), 1, speed, unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// DragInt16 calls DragInt16V(label, value, 1.0, 0, 0, "", SliderFlagsNone).
+func DragInt16(label string, value *int16) bool {
+	return DragInt16V(label, value, 1.0, 0, 0, "", SliderFlagsNone)
+}
+
+// DragInt16SliceV creates draggable sliders for all values of the slice, in one line.
+// A min that is not less than max does not clamp the values.
+func DragInt16SliceV(label string, values []int16, speed float32, min, max int16, format string, flags SliderFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	return DragScalarNV(label, DataTypeInt16, unsafe.Pointer(&values[0]), len(values), speed,
+		unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// DragInt16Slice calls DragInt16SliceV(label, values, 1.0, 0, 0, "", SliderFlagsNone).
+func DragInt16Slice(label string, values []int16) bool {
+	return DragInt16SliceV(label, values, 1.0, 0, 0, "", SliderFlagsNone)
+}
+
+// DragUint16V creates a draggable slider for a uint16 value. A min that is not less than max does not clamp the value.
+func DragUint16V(label string, value *uint16, speed float32, min, max uint16, format string, flags SliderFlags) bool {
+	return DragScalarNV(label, DataTypeUint16, unsafe.Pointer(value), 1, speed, unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// DragUint16 calls DragUint16V(label, value, 1.0, 0, 0, "", SliderFlagsNone).
+func DragUint16(label string, value *uint16) bool {
+	return DragUint16V(label, value, 1.0, 0, 0, "", SliderFlagsNone)
+}
+
+// DragUint16SliceV creates draggable sliders for all values of the slice, in one line.
+// A min that is not less than max does not clamp the values.
+func DragUint16SliceV(label string, values []uint16, speed float32, min, max uint16, format string, flags SliderFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	return DragScalarNV(label, DataTypeUint16, unsafe.Pointer(&values[0]), len(values), speed,
+		unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// DragUint16Slice calls DragUint16SliceV(label, values, 1.0, 0, 0, "", SliderFlagsNone).
+func DragUint16Slice(label string, values []uint16) bool {
+	return DragUint16SliceV(label, values, 1.0, 0, 0, "", SliderFlagsNone)
+}
+
+// DragInt32SliceV creates draggable sliders for all values of the slice, in one line.
+// A min that is not less than max does not clamp the values.
+func DragInt32SliceV(label string, values []int32, speed float32, min, max int32, format string, flags SliderFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	return DragScalarNV(label, DataTypeInt32, unsafe.Pointer(&values[0]), len(values), speed,
+		unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// DragInt32Slice calls DragInt32SliceV(label, values, 1.0, 0, 0, "", SliderFlagsNone).
+func DragInt32Slice(label string, values []int32) bool {
+	return DragInt32SliceV(label, values, 1.0, 0, 0, "", SliderFlagsNone)
+}
+
+// DragUint32V creates a draggable slider for a uint32 value. A min that is not less than max does not clamp the value.
+func DragUint32V(label string, value *uint32, speed float32, min, max uint32, format string, flags SliderFlags) bool {
+	return DragScalarNV(label, DataTypeUint32, unsafe.Pointer(value), 1, speed, unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// DragUint32 calls DragUint32V(label, value, 1.0, 0, 0, "", SliderFlagsNone).
+func DragUint32(label string, value *uint32) bool {
+	return DragUint32V(label, value, 1.0, 0, 0, "", SliderFlagsNone)
+}
+
+// DragUint32SliceV creates draggable sliders for all values of the slice, in one line.
+// A min that is not less than max does not clamp the values.
+func DragUint32SliceV(label string, values []uint32, speed float32, min, max uint32, format string, flags SliderFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	return DragScalarNV(label, DataTypeUint32, unsafe.Pointer(&values[0]), len(values), speed,
+		unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// DragUint32Slice calls DragUint32SliceV(label, values, 1.0, 0, 0, "", SliderFlagsNone).
+func DragUint32Slice(label string, values []uint32) bool {
+	return DragUint32SliceV(label, values, 1.0, 0, 0, "", SliderFlagsNone)
+}
+
+// DragInt64V creates a draggable slider for an int64 value. A min that is not less than max does not clamp the value.
+func DragInt64V(label string, value *int64, speed float32, min, max int64, format string, flags SliderFlags) bool {
+	return DragScalarNV(label, DataTypeInt64, unsafe.Pointer(value), 1, speed, unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// DragInt64 calls DragInt64V(label, value, 1.0, 0, 0, "", SliderFlagsNone).
+func DragInt64(label string, value *int64) bool {
+	return DragInt64V(label, value, 1.0, 0, 0, "", SliderFlagsNone)
+}
+
+// DragInt64SliceV creates draggable sliders for all values of the slice, in one line.
+// A min that is not less than max does not clamp the values.
+func DragInt64SliceV(label string, values []int64, speed float32, min, max int64, format string, flags SliderFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	return DragScalarNV(label, DataTypeInt64, unsafe.Pointer(&values[0]), len(values), speed,
+		unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// DragInt64Slice calls DragInt64SliceV(label, values, 1.0, 0, 0, "", SliderFlagsNone).
+func DragInt64Slice(label string, values []int64) bool {
+	return DragInt64SliceV(label, values, 1.0, 0, 0, "", SliderFlagsNone)
+}
+
+// DragUint64V creates a draggable slider for a uint64 value. A min that is not less than max does not clamp the value.
+func DragUint64V(label string, value *uint64, speed float32, min, max uint64, format string, flags SliderFlags) bool {
+	return DragScalarNV(label, DataTypeUint64, unsafe.Pointer(value), 1, speed, unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// DragUint64 calls DragUint64V(label, value, 1.0, 0, 0, "", SliderFlagsNone).
+func DragUint64(label string, value *uint64) bool {
+	return DragUint64V(label, value, 1.0, 0, 0, "", SliderFlagsNone)
+}
+
+// DragUint64SliceV creates draggable sliders for all values of the slice, in one line.
+// A min that is not less than max does not clamp the values.
+func DragUint64SliceV(label string, values []uint64, speed float32, min, max uint64, format string, flags SliderFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	return DragScalarNV(label, DataTypeUint64, unsafe.Pointer(&values[0]), len(values), speed,
+		unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// DragUint64Slice calls DragUint64SliceV(label, values, 1.0, 0, 0, "", SliderFlagsNone).
+func DragUint64Slice(label string, values []uint64) bool {
+	return DragUint64SliceV(label, values, 1.0, 0, 0, "", SliderFlagsNone)
+}
+
+// DragFloat32SliceV creates draggable sliders for all values of the slice, in one line.
+// A min that is not less than max does not clamp the values.
+func DragFloat32SliceV(label string, values []float32, speed float32, min, max float32, format string, flags SliderFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	return DragScalarNV(label, DataTypeFloat32, unsafe.Pointer(&values[0]), len(values), speed,
+		unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// DragFloat32Slice calls DragFloat32SliceV(label, values, 1.0, 0, 0, "", SliderFlagsNone).
+func DragFloat32Slice(label string, values []float32) bool {
+	return DragFloat32SliceV(label, values, 1.0, 0, 0, "", SliderFlagsNone)
+}
+
+// DragFloat64V creates a draggable slider for a float64 value. A min that is not less than max does not clamp the value.
+func DragFloat64V(label string, value *float64, speed float32, min, max float64, format string, flags SliderFlags) bool {
+	return DragScalarNV(label, DataTypeFloat64, unsafe.Pointer(value), 1, speed, unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// DragFloat64 calls DragFloat64V(label, value, 1.0, 0, 0, "", SliderFlagsNone).
+func DragFloat64(label string, value *float64) bool {
+	return DragFloat64V(label, value, 1.0, 0, 0, "", SliderFlagsNone)
+}
+
+// DragFloat64SliceV creates draggable sliders for all values of the slice, in one line.
+// A min that is not less than max does not clamp the values.
+func DragFloat64SliceV(label string, values []float64, speed float32, min, max float64, format string, flags SliderFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	return DragScalarNV(label, DataTypeFloat64, unsafe.Pointer(&values[0]), len(values), speed,
+		unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// DragFloat64Slice calls DragFloat64SliceV(label, values, 1.0, 0, 0, "", SliderFlagsNone).
+func DragFloat64Slice(label string, values []float64) bool {
+	return DragFloat64SliceV(label, values, 1.0, 0, 0, "", SliderFlagsNone)
+}
+
+// SliderInt8V creates a slider for an int8 value within min and max.
+func SliderInt8V(label string, value *int8, min, max int8, format string, flags SliderFlags) bool {
+	return SliderScalarNV(label, DataTypeInt8, unsafe.Pointer(value), 1, unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// SliderInt8 calls SliderInt8V(label, value, min, max, "", SliderFlagsNone).
+func SliderInt8(label string, value *int8, min, max int8) bool {
+	return SliderInt8V(label, value, min, max, "", SliderFlagsNone)
+}
+
+// SliderInt8SliceV creates sliders for all values of the slice within min and max, in one line.
+func SliderInt8SliceV(label string, values []int8, min, max int8, format string, flags SliderFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	return SliderScalarNV(label, DataTypeInt8, unsafe.Pointer(&values[0]), len(values),
+		unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// SliderInt8Slice calls SliderInt8SliceV(label, values, min, max, "", SliderFlagsNone).
+func SliderInt8Slice(label string, values []int8, min, max int8) bool {
+	return SliderInt8SliceV(label, values, min, max, "", SliderFlagsNone)
+}
+
+// SliderUint8V creates a slider for a uint8 value within min and max.
+func SliderUint8V(label string, value *uint8, min, max uint8, format string, flags SliderFlags) bool {
+	return SliderScalarNV(label, DataTypeUint8, unsafe.Pointer(value), 1, unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// SliderUint8 calls SliderUint8V(label, value, min, max, "", SliderFlagsNone).
+func SliderUint8(label string, value *uint8, min, max uint8) bool {
+	return SliderUint8V(label, value, min, max, "", SliderFlagsNone)
+}
+
+// SliderUint8SliceV creates sliders for all values of the slice within min and max, in one line.
+func SliderUint8SliceV(label string, values []uint8, min, max uint8, format string, flags SliderFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	return SliderScalarNV(label, DataTypeUint8, unsafe.Pointer(&values[0]), len(values),
+		unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// SliderUint8Slice calls SliderUint8SliceV(label, values, min, max, "", SliderFlagsNone).
+func SliderUint8Slice(label string, values []uint8, min, max uint8) bool {
+	return SliderUint8SliceV(label, values, min, max, "", SliderFlagsNone)
+}
+
+// SliderInt16V creates a slider for an int16 value within min and max.
+func SliderInt16V(label string, value *int16, min, max int16, format string, flags SliderFlags) bool {
+	return SliderScalarNV(label, DataTypeInt16, unsafe.Pointer(value), 1, unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// SliderInt16 calls SliderInt16V(label, value, min, max, "", SliderFlagsNone).
+func SliderInt16(label string, value *int16, min, max int16) bool {
+	return SliderInt16V(label, value, min, max, "", SliderFlagsNone)
+}
+
+// SliderInt16SliceV creates sliders for all values of the slice within min and max, in one line.
+func SliderInt16SliceV(label string, values []int16, min, max int16, format string, flags SliderFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	return SliderScalarNV(label, DataTypeInt16, unsafe.Pointer(&values[0]), len(values),
+		unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// SliderInt16Slice calls SliderInt16SliceV(label, values, min, max, "", SliderFlagsNone).
+func SliderInt16Slice(label string, values []int16, min, max int16) bool {
+	return SliderInt16SliceV(label, values, min, max, "", SliderFlagsNone)
+}
+
+// SliderUint16V creates a slider for a uint16 value within min and max.
+func SliderUint16V(label string, value *uint16, min, max uint16, format string, flags SliderFlags) bool {
+	return SliderScalarNV(label, DataTypeUint16, unsafe.Pointer(value), 1, unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// SliderUint16 calls SliderUint16V(label, value, min, max, "", SliderFlagsNone).
+func SliderUint16(label string, value *uint16, min, max uint16) bool {
+	return SliderUint16V(label, value, min, max, "", SliderFlagsNone)
+}
+
+// SliderUint16SliceV creates sliders for all values of the slice within min and max, in one line.
+func SliderUint16SliceV(label string, values []uint16, min, max uint16, format string, flags SliderFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	return SliderScalarNV(label, DataTypeUint16, unsafe.Pointer(&values[0]), len(values),
+		unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// SliderUint16Slice calls SliderUint16SliceV(label, values, min, max, "", SliderFlagsNone).
+func SliderUint16Slice(label string, values []uint16, min, max uint16) bool {
+	return SliderUint16SliceV(label, values, min, max, "", SliderFlagsNone)
+}
+
+// SliderInt32SliceV creates sliders for all values of the slice within min and max, in one line.
+// min and max are limited to half the range of int32, see SliderScalarNV().
+func SliderInt32SliceV(label string, values []int32, min, max int32, format string, flags SliderFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	return SliderScalarNV(label, DataTypeInt32, unsafe.Pointer(&values[0]), len(values),
+		unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// SliderInt32Slice calls SliderInt32SliceV(label, values, min, max, "", SliderFlagsNone).
+func SliderInt32Slice(label string, values []int32, min, max int32) bool {
+	return SliderInt32SliceV(label, values, min, max, "", SliderFlagsNone)
+}
+
+// SliderUint32V creates a slider for a uint32 value within min and max.
+// min and max are limited to half the range of uint32, see SliderScalarNV().
+func SliderUint32V(label string, value *uint32, min, max uint32, format string, flags SliderFlags) bool {
+	return SliderScalarNV(label, DataTypeUint32, unsafe.Pointer(value), 1, unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// SliderUint32 calls SliderUint32V(label, value, min, max, "", SliderFlagsNone).
+func SliderUint32(label string, value *uint32, min, max uint32) bool {
+	return SliderUint32V(label, value, min, max, "", SliderFlagsNone)
+}
+
+// SliderUint32SliceV creates sliders for all values of the slice within min and max, in one line.
+// min and max are limited to half the range of uint32, see SliderScalarNV().
+func SliderUint32SliceV(label string, values []uint32, min, max uint32, format string, flags SliderFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	return SliderScalarNV(label, DataTypeUint32, unsafe.Pointer(&values[0]), len(values),
+		unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// SliderUint32Slice calls SliderUint32SliceV(label, values, min, max, "", SliderFlagsNone).
+func SliderUint32Slice(label string, values []uint32, min, max uint32) bool {
+	return SliderUint32SliceV(label, values, min, max, "", SliderFlagsNone)
+}
+
+// SliderInt64V creates a slider for an int64 value within min and max.
+// min and max are limited to half the range of int64, see SliderScalarNV().
+func SliderInt64V(label string, value *int64, min, max int64, format string, flags SliderFlags) bool {
+	return SliderScalarNV(label, DataTypeInt64, unsafe.Pointer(value), 1, unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// SliderInt64 calls SliderInt64V(label, value, min, max, "", SliderFlagsNone).
+func SliderInt64(label string, value *int64, min, max int64) bool {
+	return SliderInt64V(label, value, min, max, "", SliderFlagsNone)
+}
+
+// SliderInt64SliceV creates sliders for all values of the slice within min and max, in one line.
+// min and max are limited to half the range of int64, see SliderScalarNV().
+func SliderInt64SliceV(label string, values []int64, min, max int64, format string, flags SliderFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	return SliderScalarNV(label, DataTypeInt64, unsafe.Pointer(&values[0]), len(values),
+		unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// SliderInt64Slice calls SliderInt64SliceV(label, values, min, max, "", SliderFlagsNone).
+func SliderInt64Slice(label string, values []int64, min, max int64) bool {
+	return SliderInt64SliceV(label, values, min, max, "", SliderFlagsNone)
+}
+
+// SliderUint64V creates a slider for a uint64 value within min and max.
+// min and max are limited to half the range of uint64, see SliderScalarNV().
+func SliderUint64V(label string, value *uint64, min, max uint64, format string, flags SliderFlags) bool {
+	return SliderScalarNV(label, DataTypeUint64, unsafe.Pointer(value), 1, unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// SliderUint64 calls SliderUint64V(label, value, min, max, "", SliderFlagsNone).
+func SliderUint64(label string, value *uint64, min, max uint64) bool {
+	return SliderUint64V(label, value, min, max, "", SliderFlagsNone)
+}
+
+// SliderUint64SliceV creates sliders for all values of the slice within min and max, in one line.
+// min and max are limited to half the range of uint64, see SliderScalarNV().
+func SliderUint64SliceV(label string, values []uint64, min, max uint64, format string, flags SliderFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	return SliderScalarNV(label, DataTypeUint64, unsafe.Pointer(&values[0]), len(values),
+		unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// SliderUint64Slice calls SliderUint64SliceV(label, values, min, max, "", SliderFlagsNone).
+func SliderUint64Slice(label string, values []uint64, min, max uint64) bool {
+	return SliderUint64SliceV(label, values, min, max, "", SliderFlagsNone)
+}
+
+// SliderFloat32SliceV creates sliders for all values of the slice within min and max, in one line.
+func SliderFloat32SliceV(label string, values []float32, min, max float32, format string, flags SliderFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	return SliderScalarNV(label, DataTypeFloat32, unsafe.Pointer(&values[0]), len(values),
+		unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// SliderFloat32Slice calls SliderFloat32SliceV(label, values, min, max, "", SliderFlagsNone).
+func SliderFloat32Slice(label string, values []float32, min, max float32) bool {
+	return SliderFloat32SliceV(label, values, min, max, "", SliderFlagsNone)
+}
+
+// SliderFloat64V creates a slider for a float64 value within min and max.
+func SliderFloat64V(label string, value *float64, min, max float64, format string, flags SliderFlags) bool {
+	return SliderScalarNV(label, DataTypeFloat64, unsafe.Pointer(value), 1, unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// SliderFloat64 calls SliderFloat64V(label, value, min, max, "", SliderFlagsNone).
+func SliderFloat64(label string, value *float64, min, max float64) bool {
+	return SliderFloat64V(label, value, min, max, "", SliderFlagsNone)
+}
+
+// SliderFloat64SliceV creates sliders for all values of the slice within min and max, in one line.
+func SliderFloat64SliceV(label string, values []float64, min, max float64, format string, flags SliderFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	return SliderScalarNV(label, DataTypeFloat64, unsafe.Pointer(&values[0]), len(values),
+		unsafe.Pointer(&min), unsafe.Pointer(&max), format, flags)
+}
+
+// SliderFloat64Slice calls SliderFloat64SliceV(label, values, min, max, "", SliderFlagsNone).
+func SliderFloat64Slice(label string, values []float64, min, max float64) bool {
+	return SliderFloat64SliceV(label, values, min, max, "", SliderFlagsNone)
+}
+
+// InputInt8V creates an input field for an int8 value. A zero step shows no step buttons.
+func InputInt8V(label string, value *int8, step, stepFast int8, format string, flags InputTextFlags) bool {
+	var stepArg, stepFastArg unsafe.Pointer
+	if step != 0 {
+		stepArg = unsafe.Pointer(&step)
+	}
+	if stepFast != 0 {
+		stepFastArg = unsafe.Pointer(&stepFast)
+	}
+	return InputScalarNV(label, DataTypeInt8, unsafe.Pointer(value), 1, stepArg, stepFastArg, format, flags)
+}
+
+// InputInt8 calls InputInt8V(label, value, 1, 100, "", 0).
+func InputInt8(label string, value *int8) bool {
+	return InputInt8V(label, value, 1, 100, "", 0)
+}
+
+// InputInt8SliceV creates input fields for all values of the slice, in one line. A zero step shows no step buttons.
+func InputInt8SliceV(label string, values []int8, step, stepFast int8, format string, flags InputTextFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	var stepArg, stepFastArg unsafe.Pointer
+	if step != 0 {
+		stepArg = unsafe.Pointer(&step)
+	}
+	if stepFast != 0 {
+		stepFastArg = unsafe.Pointer(&stepFast)
+	}
+	return InputScalarNV(label, DataTypeInt8, unsafe.Pointer(&values[0]), len(values), stepArg, stepFastArg, format, flags)
+}
+
+// InputInt8Slice calls InputInt8SliceV(label, values, 0, 0, "", 0).
+func InputInt8Slice(label string, values []int8) bool {
+	return InputInt8SliceV(label, values, 0, 0, "", 0)
+}
+
+// InputUint8V creates an input field for a uint8 value. A zero step shows no step buttons.
+func InputUint8V(label string, value *uint8, step, stepFast uint8, format string, flags InputTextFlags) bool {
+	var stepArg, stepFastArg unsafe.Pointer
+	if step != 0 {
+		stepArg = unsafe.Pointer(&step)
+	}
+	if stepFast != 0 {
+		stepFastArg = unsafe.Pointer(&stepFast)
+	}
+	return InputScalarNV(label, DataTypeUint8, unsafe.Pointer(value), 1, stepArg, stepFastArg, format, flags)
+}
+
+// InputUint8 calls InputUint8V(label, value, 1, 100, "", 0).
+func InputUint8(label string, value *uint8) bool {
+	return InputUint8V(label, value, 1, 100, "", 0)
+}
+
+// InputUint8SliceV creates input fields for all values of the slice, in one line. A zero step shows no step buttons.
+func InputUint8SliceV(label string, values []uint8, step, stepFast uint8, format string, flags InputTextFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	var stepArg, stepFastArg unsafe.Pointer
+	if step != 0 {
+		stepArg = unsafe.Pointer(&step)
+	}
+	if stepFast != 0 {
+		stepFastArg = unsafe.Pointer(&stepFast)
+	}
+	return InputScalarNV(label, DataTypeUint8, unsafe.Pointer(&values[0]), len(values), stepArg, stepFastArg, format, flags)
+}
+
+// InputUint8Slice calls InputUint8SliceV(label, values, 0, 0, "", 0).
+func InputUint8Slice(label string, values []uint8) bool {
+	return InputUint8SliceV(label, values, 0, 0, "", 0)
+}
+
+// InputInt16V creates an input field for an int16 value. A zero step shows no step buttons.
+func InputInt16V(label string, value *int16, step, stepFast int16, format string, flags InputTextFlags) bool {
+	var stepArg, stepFastArg unsafe.Pointer
+	if step != 0 {
+		stepArg = unsafe.Pointer(&step)
+	}
+	if stepFast != 0 {
+		stepFastArg = unsafe.Pointer(&stepFast)
+	}
+	return InputScalarNV(label, DataTypeInt16, unsafe.Pointer(value), 1, stepArg, stepFastArg, format, flags)
+}
+
+// InputInt16 calls InputInt16V(label, value, 1, 100, "", 0).
+func InputInt16(label string, value *int16) bool {
+	return InputInt16V(label, value, 1, 100, "", 0)
+}
+
+// InputInt16SliceV creates input fields for all values of the slice, in one line. A zero step shows no step buttons.
+func InputInt16SliceV(label string, values []int16, step, stepFast int16, format string, flags InputTextFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	var stepArg, stepFastArg unsafe.Pointer
+	if step != 0 {
+		stepArg = unsafe.Pointer(&step)
+	}
+	if stepFast != 0 {
+		stepFastArg = unsafe.Pointer(&stepFast)
+	}
+	return InputScalarNV(label, DataTypeInt16, unsafe.Pointer(&values[0]), len(values), stepArg, stepFastArg, format, flags)
+}
+
+// InputInt16Slice calls InputInt16SliceV(label, values, 0, 0, "", 0).
+func InputInt16Slice(label string, values []int16) bool {
+	return InputInt16SliceV(label, values, 0, 0, "", 0)
+}
+
+// InputUint16V creates an input field for a uint16 value. A zero step shows no step buttons.
+func InputUint16V(label string, value *uint16, step, stepFast uint16, format string, flags InputTextFlags) bool {
+	var stepArg, stepFastArg unsafe.Pointer
+	if step != 0 {
+		stepArg = unsafe.Pointer(&step)
+	}
+	if stepFast != 0 {
+		stepFastArg = unsafe.Pointer(&stepFast)
+	}
+	return InputScalarNV(label, DataTypeUint16, unsafe.Pointer(value), 1, stepArg, stepFastArg, format, flags)
+}
+
+// InputUint16 calls InputUint16V(label, value, 1, 100, "", 0).
+func InputUint16(label string, value *uint16) bool {
+	return InputUint16V(label, value, 1, 100, "", 0)
+}
+
+// InputUint16SliceV creates input fields for all values of the slice, in one line. A zero step shows no step buttons.
+func InputUint16SliceV(label string, values []uint16, step, stepFast uint16, format string, flags InputTextFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	var stepArg, stepFastArg unsafe.Pointer
+	if step != 0 {
+		stepArg = unsafe.Pointer(&step)
+	}
+	if stepFast != 0 {
+		stepFastArg = unsafe.Pointer(&stepFast)
+	}
+	return InputScalarNV(label, DataTypeUint16, unsafe.Pointer(&values[0]), len(values), stepArg, stepFastArg, format, flags)
+}
+
+// InputUint16Slice calls InputUint16SliceV(label, values, 0, 0, "", 0).
+func InputUint16Slice(label string, values []uint16) bool {
+	return InputUint16SliceV(label, values, 0, 0, "", 0)
+}
+
+// InputInt32SliceV creates input fields for all values of the slice, in one line. A zero step shows no step buttons.
+func InputInt32SliceV(label string, values []int32, step, stepFast int32, format string, flags InputTextFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	var stepArg, stepFastArg unsafe.Pointer
+	if step != 0 {
+		stepArg = unsafe.Pointer(&step)
+	}
+	if stepFast != 0 {
+		stepFastArg = unsafe.Pointer(&stepFast)
+	}
+	return InputScalarNV(label, DataTypeInt32, unsafe.Pointer(&values[0]), len(values), stepArg, stepFastArg, format, flags)
+}
+
+// InputInt32Slice calls InputInt32SliceV(label, values, 0, 0, "", 0).
+func InputInt32Slice(label string, values []int32) bool {
+	return InputInt32SliceV(label, values, 0, 0, "", 0)
+}
+
+// InputUint32V creates an input field for a uint32 value. A zero step shows no step buttons.
+func InputUint32V(label string, value *uint32, step, stepFast uint32, format string, flags InputTextFlags) bool {
+	var stepArg, stepFastArg unsafe.Pointer
+	if step != 0 {
+		stepArg = unsafe.Pointer(&step)
+	}
+	if stepFast != 0 {
+		stepFastArg = unsafe.Pointer(&stepFast)
+	}
+	return InputScalarNV(label, DataTypeUint32, unsafe.Pointer(value), 1, stepArg, stepFastArg, format, flags)
+}
+
+// InputUint32 calls InputUint32V(label, value, 1, 100, "", 0).
+func InputUint32(label string, value *uint32) bool {
+	return InputUint32V(label, value, 1, 100, "", 0)
+}
+
+// InputUint32SliceV creates input fields for all values of the slice, in one line. A zero step shows no step buttons.
+func InputUint32SliceV(label string, values []uint32, step, stepFast uint32, format string, flags InputTextFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	var stepArg, stepFastArg unsafe.Pointer
+	if step != 0 {
+		stepArg = unsafe.Pointer(&step)
+	}
+	if stepFast != 0 {
+		stepFastArg = unsafe.Pointer(&stepFast)
+	}
+	return InputScalarNV(label, DataTypeUint32, unsafe.Pointer(&values[0]), len(values), stepArg, stepFastArg, format, flags)
+}
+
+// InputUint32Slice calls InputUint32SliceV(label, values, 0, 0, "", 0).
+func InputUint32Slice(label string, values []uint32) bool {
+	return InputUint32SliceV(label, values, 0, 0, "", 0)
+}
+
+// InputInt64V creates an input field for an int64 value. A zero step shows no step buttons.
+func InputInt64V(label string, value *int64, step, stepFast int64, format string, flags InputTextFlags) bool {
+	var stepArg, stepFastArg unsafe.Pointer
+	if step != 0 {
+		stepArg = unsafe.Pointer(&step)
+	}
+	if stepFast != 0 {
+		stepFastArg = unsafe.Pointer(&stepFast)
+	}
+	return InputScalarNV(label, DataTypeInt64, unsafe.Pointer(value), 1, stepArg, stepFastArg, format, flags)
+}
+
+// InputInt64 calls InputInt64V(label, value, 1, 100, "", 0).
+func InputInt64(label string, value *int64) bool {
+	return InputInt64V(label, value, 1, 100, "", 0)
+}
+
+// InputInt64SliceV creates input fields for all values of the slice, in one line. A zero step shows no step buttons.
+func InputInt64SliceV(label string, values []int64, step, stepFast int64, format string, flags InputTextFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	var stepArg, stepFastArg unsafe.Pointer
+	if step != 0 {
+		stepArg = unsafe.Pointer(&step)
+	}
+	if stepFast != 0 {
+		stepFastArg = unsafe.Pointer(&stepFast)
+	}
+	return InputScalarNV(label, DataTypeInt64, unsafe.Pointer(&values[0]), len(values), stepArg, stepFastArg, format, flags)
+}
+
+// InputInt64Slice calls InputInt64SliceV(label, values, 0, 0, "", 0).
+func InputInt64Slice(label string, values []int64) bool {
+	return InputInt64SliceV(label, values, 0, 0, "", 0)
+}
+
+// InputUint64V creates an input field for a uint64 value. A zero step shows no step buttons.
+func InputUint64V(label string, value *uint64, step, stepFast uint64, format string, flags InputTextFlags) bool {
+	var stepArg, stepFastArg unsafe.Pointer
+	if step != 0 {
+		stepArg = unsafe.Pointer(&step)
+	}
+	if stepFast != 0 {
+		stepFastArg = unsafe.Pointer(&stepFast)
+	}
+	return InputScalarNV(label, DataTypeUint64, unsafe.Pointer(value), 1, stepArg, stepFastArg, format, flags)
+}
+
+// InputUint64 calls InputUint64V(label, value, 1, 100, "", 0).
+func InputUint64(label string, value *uint64) bool {
+	return InputUint64V(label, value, 1, 100, "", 0)
+}
+
+// InputUint64SliceV creates input fields for all values of the slice, in one line. A zero step shows no step buttons.
+func InputUint64SliceV(label string, values []uint64, step, stepFast uint64, format string, flags InputTextFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	var stepArg, stepFastArg unsafe.Pointer
+	if step != 0 {
+		stepArg = unsafe.Pointer(&step)
+	}
+	if stepFast != 0 {
+		stepFastArg = unsafe.Pointer(&stepFast)
+	}
+	return InputScalarNV(label, DataTypeUint64, unsafe.Pointer(&values[0]), len(values), stepArg, stepFastArg, format, flags)
+}
+
+// InputUint64Slice calls InputUint64SliceV(label, values, 0, 0, "", 0).
+func InputUint64Slice(label string, values []uint64) bool {
+	return InputUint64SliceV(label, values, 0, 0, "", 0)
+}
+
+// InputFloat32SliceV creates input fields for all values of the slice, in one line. A zero step shows no step buttons.
+func InputFloat32SliceV(label string, values []float32, step, stepFast float32, format string, flags InputTextFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	var stepArg, stepFastArg unsafe.Pointer
+	if step != 0 {
+		stepArg = unsafe.Pointer(&step)
+	}
+	if stepFast != 0 {
+		stepFastArg = unsafe.Pointer(&stepFast)
+	}
+	return InputScalarNV(label, DataTypeFloat32, unsafe.Pointer(&values[0]), len(values), stepArg, stepFastArg, format, flags)
+}
+
+// InputFloat32Slice calls InputFloat32SliceV(label, values, 0, 0, "", 0).
+func InputFloat32Slice(label string, values []float32) bool {
+	return InputFloat32SliceV(label, values, 0, 0, "", 0)
+}
+
+// InputDoubleV creates an input field for a float64 value. A zero step shows no step buttons.
+func InputDoubleV(label string, value *float64, step, stepFast float64, format string, flags InputTextFlags) bool {
+	var stepArg, stepFastArg unsafe.Pointer
+	if step != 0 {
+		stepArg = unsafe.Pointer(&step)
+	}
+	if stepFast != 0 {
+		stepFastArg = unsafe.Pointer(&stepFast)
+	}
+	return InputScalarNV(label, DataTypeFloat64, unsafe.Pointer(value), 1, stepArg, stepFastArg, format, flags)
+}
+
+// InputDouble calls InputDoubleV(label, value, 0, 0, "%.6f", 0).
+func InputDouble(label string, value *float64) bool {
+	return InputDoubleV(label, value, 0, 0, "%.6f", 0)
+}
+
+// InputFloat64SliceV creates input fields for all values of the slice, in one line. A zero step shows no step buttons.
+func InputFloat64SliceV(label string, values []float64, step, stepFast float64, format string, flags InputTextFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	var stepArg, stepFastArg unsafe.Pointer
+	if step != 0 {
+		stepArg = unsafe.Pointer(&step)
+	}
+	if stepFast != 0 {
+		stepFastArg = unsafe.Pointer(&stepFast)
+	}
+	return InputScalarNV(label, DataTypeFloat64, unsafe.Pointer(&values[0]), len(values), stepArg, stepFastArg, format, flags)
+}
+
+// InputFloat64Slice calls InputFloat64SliceV(label, values, 0, 0, "", 0).
+func InputFloat64Slice(label string, values []float64) bool {
+	return InputFloat64SliceV(label, values, 0, 0, "", 0)
+}
@@ -0,0 +1,88 @@
+package imgui_test
+
+import (
+	"math"
+	"testing"
+	"unsafe"
+
+	"github.com/ianling/imgui-go"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScalarWidgetsKeepValuesWithoutInput(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 800, Y: 600})
+	defer context.Destroy()
+
+	int8Value := int8(-5)
+	uint16Value := uint16(500)
+	int64Value := int64(1 << 40)
+	uint64Value := uint64(1 << 63)
+	float64Value := 0.25
+	float32Values := []float32{1, 2, 3, 4, 5}
+	uint32Values := []uint32{7, 8, 9}
+
+	var changed bool
+	renderTestWindow(func() {
+		changed = imgui.DragInt8("int8", &int8Value)
+		changed = imgui.SliderUint16("uint16", &uint16Value, 0, 1000) || changed
+		changed = imgui.InputInt64("int64", &int64Value) || changed
+		changed = imgui.DragUint64V("uint64", &uint64Value, 1, 0, 0, "%llx", imgui.SliderFlagsNone) || changed
+		changed = imgui.InputDouble("double", &float64Value) || changed
+		changed = imgui.SliderFloat64("float64", &float64Value, 0, 1) || changed
+		changed = imgui.DragFloat32Slice("float32s", float32Values) || changed
+		changed = imgui.InputUint32SliceV("uint32s", uint32Values, 1, 10, "", 0) || changed
+		assert.False(t, imgui.SliderInt32Slice("empty", nil, 0, 10), "Empty slices should not be rendered")
+	})
+
+	assert.False(t, changed, "No value should be changed without input")
+	assert.Equal(t, int8(-5), int8Value)
+	assert.Equal(t, uint16(500), uint16Value)
+	assert.Equal(t, int64(1<<40), int64Value)
+	assert.Equal(t, uint64(1<<63), uint64Value)
+	assert.Equal(t, 0.25, float64Value)
+	assert.Equal(t, []float32{1, 2, 3, 4, 5}, float32Values)
+	assert.Equal(t, []uint32{7, 8, 9}, uint32Values)
+}
+
+func TestScalarWidgetsEditValues(t *testing.T) {
+	context := newTestContext(imgui.Vec2{X: 400, Y: 300})
+	defer context.Destroy()
+	io := imgui.CurrentIO()
+	const keyEnter = 1
+	io.KeyMap(imgui.KeyEnter, keyEnter)
+
+	int8Value := int8(-5)
+	int64Value := int64(0)
+	uint32Value := uint32(0)
+	render := func() {
+		renderTestWindow(func() {
+			imgui.InputInt8("int8", &int8Value)
+			imgui.SliderInt64("int64", &int64Value, math.MinInt64, math.MaxInt64)
+			imgui.SliderUint32("uint32", &uint32Value, 0, math.MaxUint32)
+		})
+	}
+	render()
+
+	// The input field is the first item; clicking it selects its text.
+	io.SetMousePosition(imgui.Vec2{X: 30, Y: 17})
+	for _, down := range []bool{true, false} {
+		io.SetMouseButtonDown(0, down)
+		render()
+	}
+	io.AddInputCharacters("42")
+	render()
+	io.KeyPress(keyEnter)
+	render()
+	io.KeyRelease(keyEnter)
+	render()
+	assert.Equal(t, int8(42), int8Value, "Typed value should be set")
+
+	io.AddInputCharacters("1000")
+	render()
+	assert.Equal(t, int8(42), int8Value, "Input should end with Enter")
+
+	assert.Panics(t, func() {
+		imgui.SliderScalarN("nil", imgui.DataTypeInt8, unsafe.Pointer(&int8Value), 1, nil, nil)
+	}, "Sliders should require min and max")
+}
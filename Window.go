@@ -88,7 +88,7 @@ const (
 // Returns false if the window is currently not visible.
 // Regardless of the return value, End() must be called for each call to Begin().
 func BeginV(id string, open *bool, flags WindowFlags) bool {
-	idArg, idFin := wrapString(id)
+	idArg, idFin := wrapString(submittedLabel(id))
 	defer idFin()
 	openArg, openFin := wrapBool(open)
 	defer openFin()
@@ -330,6 +330,11 @@ func PopTextWrapPos() {
 	C.iggPopTextWrapPos()
 }
 
+// textWrapWidth returns the width at which Text() wraps at the current position, or -1 if it doesn't wrap.
+func textWrapWidth() float32 {
+	return float32(C.iggTextWrapWidth())
+}
+
 // PushButtonRepeat enables button to repeat press if held.
 func PushButtonRepeat(repeat bool) {
 	C.iggPushButtonRepeat(castBool(repeat))
@@ -62,6 +62,9 @@ func wrapString(value string) (wrapped *C.char, finisher func()) {
 }
 
 func wrapBytes(value []byte) (wrapped unsafe.Pointer, finisher func()) {
+	if len(value) == 0 {
+		return nil, func() {}
+	}
 	wrapped = C.CBytes(value)
 	finisher = func() { C.free(wrapped) } // nolint: gas
 	return
@@ -0,0 +1,190 @@
+package bmfont
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"strings"
+	"sync"
+
+	"github.com/ianling/imgui-go"
+)
+
+// AtlasFont is a bitmap font that was added to a font atlas.
+type AtlasFont struct {
+	atlas    imgui.FontAtlas
+	font     imgui.Font
+	pages    []image.Image
+	glyphs   []atlasGlyph
+	kernings map[KerningPair]int
+}
+
+type atlasGlyph struct {
+	rect int
+	char Char
+}
+
+var spaceGlyphRanges = struct {
+	sync.Once
+	ranges imgui.GlyphRanges
+}{}
+
+// placeholderGlyphRanges returns the ranges of the placeholder font, which only contain the space character.
+// The ranges are kept for the lifetime of the process, as font atlases refer to them.
+func placeholderGlyphRanges() imgui.GlyphRanges {
+	spaceGlyphRanges.Do(func() {
+		builder := imgui.NewFontGlyphRangesBuilder()
+		builder.AddChar(' ')
+		ranges := imgui.NewGlyphRanges()
+		builder.BuildRanges(ranges)
+		spaceGlyphRanges.ranges = ranges.Data()
+	})
+	return spaceGlyphRanges.ranges
+}
+
+// AddToAtlas adds the font to given atlas. The glyphs are requested as custom rectangles of the atlas,
+// and the pages are the images the glyphs are copied from with Rasterize().
+//
+// The font is based on the default font of imgui, reduced to the space character, with a size of LineHeight.
+// Bitmap fonts are not scaled, neither by imgui.DPIScale. Characters beyond imgui.UnicodeCodepointMax are ignored.
+// imgui does not apply kerning, as its fonts have no kerning pairs. Text that is displayed with Text() and
+// measured with CalcTextSize() of the AtlasFont is kerned.
+func (font *Font) AddToAtlas(atlas imgui.FontAtlas, pages []image.Image) *AtlasFont {
+	config := imgui.NewFontConfig()
+	defer config.Delete()
+	config.SetName(font.Face)
+	config.SetSize(float32(font.LineHeight))
+	config.SetGlyphRanges(placeholderGlyphRanges())
+	added := &AtlasFont{
+		atlas:    atlas,
+		font:     atlas.AddFontDefaultV(config),
+		pages:    pages,
+		kernings: font.KerningMap(),
+	}
+	for _, char := range font.Chars {
+		if (char.ID <= 0) || (char.ID > imgui.UnicodeCodepointMax) {
+			continue
+		}
+		// Atlas rectangles can not be empty; invisible characters, such as the space, get a transparent pixel.
+		width, height := char.Width, char.Height
+		if (width == 0) || (height == 0) {
+			width, height = 1, 1
+			char.Width, char.Height = 0, 0
+		}
+		rect := atlas.AddCustomRectFontGlyph(added.font, char.ID, width, height, float32(char.XAdvance),
+			imgui.Vec2{X: float32(char.XOffset), Y: float32(char.YOffset)})
+		added.glyphs = append(added.glyphs, atlasGlyph{rect: rect, char: char})
+	}
+	return added
+}
+
+// Font returns the imgui font, to be used with imgui.PushFont().
+func (added *AtlasFont) Font() imgui.Font {
+	return added.font
+}
+
+// CalcTextSize returns the size of the text when displayed with Text(), including kerning.
+// Lines are separated by newline characters.
+func (added *AtlasFont) CalcTextSize(text string) imgui.Vec2 {
+	var size imgui.Vec2
+	for _, line := range strings.Split(text, "\n") {
+		width := float32(0)
+		added.kernedSegments(line, func(offset float32, segment string) {
+			width = offset + added.segmentWidth(segment)
+		})
+		if width > size.X {
+			size.X = width
+		}
+		size.Y += added.font.FontSize()
+	}
+	return size
+}
+
+// Text adds the text at the cursor position, with kerning applied, and advances the cursor past it.
+// Lines are separated by newline characters. It must be called within a window.
+func (added *AtlasFont) Text(text string) {
+	imgui.PushFont(added.font)
+	defer imgui.PopFont()
+
+	pos := imgui.CursorScreenPos()
+	list := imgui.WindowDrawList()
+	textColor := imgui.PackedColorFromVec4(imgui.CurrentStyle().Color(imgui.StyleColorText))
+	for index, line := range strings.Split(text, "\n") {
+		linePos := imgui.Vec2{X: pos.X, Y: pos.Y + float32(index)*added.font.FontSize()}
+		added.kernedSegments(line, func(offset float32, segment string) {
+			list.AddText(imgui.Vec2{X: linePos.X + offset, Y: linePos.Y}, textColor, segment)
+		})
+	}
+	imgui.Dummy(added.CalcTextSize(text))
+}
+
+// kernedSegments splits the line at all pairs of characters with kerning, and calls the function with each segment
+// and its horizontal offset from the start of the line.
+func (added *AtlasFont) kernedSegments(line string, segment func(offset float32, text string)) {
+	offset := float32(0)
+	start := 0
+	previous := rune(-1)
+	for index, r := range line {
+		if amount := added.kernings[KerningPair{First: previous, Second: r}]; amount != 0 {
+			text := line[start:index]
+			segment(offset, text)
+			offset += added.segmentWidth(text) + float32(amount)
+			start = index
+		}
+		previous = r
+	}
+	segment(offset, line[start:])
+}
+
+func (added *AtlasFont) segmentWidth(text string) float32 {
+	size, _ := added.font.CalcTextSizeA(added.font.FontSize(), math.MaxFloat32, 0, text)
+	return size.X
+}
+
+// Rasterize copies the glyphs from the pages into the RGBA32 texture data of the atlas, building it if necessary.
+// It must be called after every build of the atlas, before the texture is uploaded. AtlasFont implements
+// imgui.FontAtlasRasterizer, so renderers can do this, for example with imgui.OpenGL3.AddFontRasterizer().
+//
+// Glyphs of a single channel become white with the channel as alpha; other glyphs are copied in color.
+func (added *AtlasFont) Rasterize() *imgui.RGBA32Image {
+	texture := added.atlas.TextureDataRGBA32()
+	pixels := (*[1 << 30]byte)(texture.Pixels)[: texture.Width*texture.Height*4 : texture.Width*texture.Height*4]
+	for _, glyph := range added.glyphs {
+		rect := added.atlas.CustomRectByIndex(glyph.rect)
+		if !rect.IsPacked() || (glyph.char.Page >= len(added.pages)) || (added.pages[glyph.char.Page] == nil) {
+			continue
+		}
+		page := added.pages[glyph.char.Page]
+		// Glyph positions are relative to the page, whose bounds need not start at the origin, as for sub-images.
+		origin := page.Bounds().Min
+		for y := 0; y < glyph.char.Height; y++ {
+			for x := 0; x < glyph.char.Width; x++ {
+				pixel := channelColor(page.At(origin.X+glyph.char.X+x, origin.Y+glyph.char.Y+y), glyph.char.Channel)
+				offset := ((rect.Y+y)*texture.Width + rect.X + x) * 4
+				pixels[offset+0] = pixel.R
+				pixels[offset+1] = pixel.G
+				pixels[offset+2] = pixel.B
+				pixels[offset+3] = pixel.A
+			}
+		}
+	}
+	return texture
+}
+
+func channelColor(c color.Color, channel int) color.NRGBA {
+	pixel := color.NRGBAModel.Convert(c).(color.NRGBA)
+	var alpha uint8
+	switch channel {
+	case ChannelBlue:
+		alpha = pixel.B
+	case ChannelGreen:
+		alpha = pixel.G
+	case ChannelRed:
+		alpha = pixel.R
+	case ChannelAlpha:
+		alpha = pixel.A
+	default:
+		return pixel
+	}
+	return color.NRGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: alpha}
+}
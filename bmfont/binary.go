@@ -0,0 +1,143 @@
+package bmfont
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+var binaryMagic = []byte("BMF")
+
+const binaryVersion = 3
+
+// Block types of the binary format.
+const (
+	binaryBlockInfo     = 1
+	binaryBlockCommon   = 2
+	binaryBlockPages    = 3
+	binaryBlockChars    = 4
+	binaryBlockKernings = 5
+)
+
+const (
+	binaryInfoSize    = 14
+	binaryCommonSize  = 15
+	binaryCharSize    = 20
+	binaryKerningSize = 10
+)
+
+func parseBinary(data []byte) (*Font, error) {
+	if (len(data) < 4) || (data[3] != binaryVersion) {
+		return nil, FormatError{Reason: "unsupported binary version"}
+	}
+	font := &Font{}
+	rest := data[4:]
+	for len(rest) > 0 {
+		if len(rest) < 5 {
+			return nil, FormatError{Reason: "truncated block header"}
+		}
+		blockType := rest[0]
+		size := int(binary.LittleEndian.Uint32(rest[1:5]))
+		rest = rest[5:]
+		if (size < 0) || (size > len(rest)) {
+			return nil, FormatError{Reason: fmt.Sprintf("truncated block %d", blockType)}
+		}
+		block := rest[:size]
+		rest = rest[size:]
+
+		var err error
+		switch blockType {
+		case binaryBlockInfo:
+			err = font.readBinaryInfo(block)
+		case binaryBlockCommon:
+			err = font.readBinaryCommon(block)
+		case binaryBlockPages:
+			font.readBinaryPages(block)
+		case binaryBlockChars:
+			err = font.readBinaryChars(block)
+		case binaryBlockKernings:
+			err = font.readBinaryKernings(block)
+		default:
+			err = FormatError{Reason: fmt.Sprintf("unknown block type %d", blockType)}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return font.validated()
+}
+
+func (font *Font) readBinaryInfo(block []byte) error {
+	if len(block) < binaryInfoSize {
+		return FormatError{Reason: "truncated info block"}
+	}
+	font.Size = int(int16(binary.LittleEndian.Uint16(block[0:2])))
+	font.Italic = (block[2] & (1 << 2)) != 0
+	font.Bold = (block[2] & (1 << 3)) != 0
+	for index := range font.Padding {
+		font.Padding[index] = int(block[7+index])
+	}
+	font.Spacing = [2]int{int(block[11]), int(block[12])}
+	name := block[binaryInfoSize:]
+	if end := bytes.IndexByte(name, 0); end >= 0 {
+		name = name[:end]
+	}
+	font.Face = string(name)
+	return nil
+}
+
+func (font *Font) readBinaryCommon(block []byte) error {
+	if len(block) < binaryCommonSize {
+		return FormatError{Reason: "truncated common block"}
+	}
+	font.LineHeight = int(binary.LittleEndian.Uint16(block[0:2]))
+	font.Base = int(binary.LittleEndian.Uint16(block[2:4]))
+	font.ScaleW = int(binary.LittleEndian.Uint16(block[4:6]))
+	font.ScaleH = int(binary.LittleEndian.Uint16(block[6:8]))
+	return nil
+}
+
+func (font *Font) readBinaryPages(block []byte) {
+	for _, name := range bytes.Split(block, []byte{0}) {
+		if len(name) > 0 {
+			font.Pages = append(font.Pages, string(name))
+		}
+	}
+}
+
+func (font *Font) readBinaryChars(block []byte) error {
+	if len(block)%binaryCharSize != 0 {
+		return FormatError{Reason: "invalid chars block size"}
+	}
+	for offset := 0; offset < len(block); offset += binaryCharSize {
+		entry := block[offset : offset+binaryCharSize]
+		font.Chars = append(font.Chars, Char{
+			ID:       rune(int32(binary.LittleEndian.Uint32(entry[0:4]))),
+			X:        int(binary.LittleEndian.Uint16(entry[4:6])),
+			Y:        int(binary.LittleEndian.Uint16(entry[6:8])),
+			Width:    int(binary.LittleEndian.Uint16(entry[8:10])),
+			Height:   int(binary.LittleEndian.Uint16(entry[10:12])),
+			XOffset:  int(int16(binary.LittleEndian.Uint16(entry[12:14]))),
+			YOffset:  int(int16(binary.LittleEndian.Uint16(entry[14:16]))),
+			XAdvance: int(int16(binary.LittleEndian.Uint16(entry[16:18]))),
+			Page:     int(entry[18]),
+			Channel:  int(entry[19]),
+		})
+	}
+	return nil
+}
+
+func (font *Font) readBinaryKernings(block []byte) error {
+	if len(block)%binaryKerningSize != 0 {
+		return FormatError{Reason: "invalid kernings block size"}
+	}
+	for offset := 0; offset < len(block); offset += binaryKerningSize {
+		entry := block[offset : offset+binaryKerningSize]
+		font.Kernings = append(font.Kernings, Kerning{
+			First:  rune(binary.LittleEndian.Uint32(entry[0:4])),
+			Second: rune(binary.LittleEndian.Uint32(entry[4:8])),
+			Amount: int(int16(binary.LittleEndian.Uint16(entry[8:10]))),
+		})
+	}
+	return nil
+}
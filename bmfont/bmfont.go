@@ -0,0 +1,191 @@
+// Package bmfont loads bitmap fonts in the AngelCode BMFont format into imgui font atlases.
+//
+// A BMFont consists of a descriptor file (.fnt), in text, XML or binary format, and one or more page images
+// that contain the glyphs. Parse() reads any of the three formats; LoadFile() additionally loads the pages,
+// which are expected next to the descriptor:
+//   font, pages, err := bmfont.LoadFile("pixel.fnt")
+//   added := font.AddToAtlas(io.Fonts(), pages)
+//   renderer.AddFontRasterizer(added) // rasterizes the glyphs after every build of the atlas
+//   ...
+//   added.Text("Score: 100") // or imgui.PushFont(added.Font()), without kerning
+package bmfont
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	// PNG is the common format of page images; others can be registered by the application.
+	_ "image/png"
+)
+
+// FormatError is returned for descriptor data that is in none of the supported formats, or that is malformed.
+type FormatError struct {
+	Reason string
+}
+
+// Error returns the string representation.
+func (err FormatError) Error() string {
+	return "bmfont: invalid format: " + err.Reason
+}
+
+// Channel flags describe in which color channels of the page image a glyph is stored.
+const (
+	ChannelBlue  = 1
+	ChannelGreen = 2
+	ChannelRed   = 4
+	ChannelAlpha = 8
+	ChannelAll   = 15
+)
+
+// Font is the descriptor of a bitmap font.
+type Font struct {
+	// Face is the name of the true type font the bitmap font was generated from.
+	Face string
+	// Size is the size of the font, in pixels. It is negative if the size matches the character height,
+	// rather than the cell height.
+	Size   int
+	Bold   bool
+	Italic bool
+	// Padding of each character, in the order up, right, down, left.
+	Padding [4]int
+	// Spacing between characters in the page images, horizontal and vertical.
+	Spacing [2]int
+
+	// LineHeight is the distance in pixels between lines of text.
+	LineHeight int
+	// Base is the distance in pixels from the top of the line to the baseline.
+	Base int
+	// ScaleW and ScaleH are the size of the page images.
+	ScaleW, ScaleH int
+
+	// Pages are the file names of the page images, relative to the descriptor.
+	Pages    []string
+	Chars    []Char
+	Kernings []Kerning
+
+	kerningOnce  sync.Once
+	kerningPairs map[KerningPair]int
+}
+
+// Char describes the glyph of a single character.
+type Char struct {
+	ID rune
+	// X, Y, Width and Height are the position and size of the glyph within its page image.
+	X, Y, Width, Height int
+	// XOffset and YOffset are the offset of the glyph relative to the pen position at the top of the line.
+	XOffset, YOffset int
+	// XAdvance is the distance to the next character.
+	XAdvance int
+	// Page is the index of the page image.
+	Page int
+	// Channel is a combination of the Channel* flags.
+	Channel int
+}
+
+// Kerning adjusts the distance between a pair of characters.
+type Kerning struct {
+	First, Second rune
+	Amount        int
+}
+
+// KerningPair identifies a pair of characters, in the order they appear in text.
+type KerningPair struct {
+	First, Second rune
+}
+
+// Parse reads a font descriptor in text, XML or binary format. Malformed data results in a FormatError.
+func Parse(data []byte) (*Font, error) {
+	switch {
+	case bytes.HasPrefix(data, binaryMagic):
+		return parseBinary(data)
+	case bytes.HasPrefix(bytes.TrimSpace(data), []byte("<")):
+		return parseXML(data)
+	default:
+		return parseText(data)
+	}
+}
+
+// LoadFile reads the font descriptor in given file, and the page images it refers to.
+func LoadFile(filename string) (*Font, []image.Image, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	font, err := Parse(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	pages, err := font.LoadPages(filepath.Dir(filename))
+	if err != nil {
+		return nil, nil, err
+	}
+	return font, pages, nil
+}
+
+// LoadPages reads the page images of the font from given directory.
+func (font *Font) LoadPages(dir string) ([]image.Image, error) {
+	pages := make([]image.Image, len(font.Pages))
+	for index, name := range font.Pages {
+		page, err := loadImage(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			return nil, err
+		}
+		pages[index] = page
+	}
+	return pages, nil
+}
+
+func loadImage(filename string) (image.Image, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+	page, _, err := image.Decode(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	return page, nil
+}
+
+// Kerning returns the adjustment of the distance between given pair of characters, in pixels.
+// The pairs are indexed with the first call; changes of Kernings after that are not considered.
+func (font *Font) Kerning(first, second rune) int {
+	font.kerningOnce.Do(func() {
+		font.kerningPairs = font.KerningMap()
+	})
+	return font.kerningPairs[KerningPair{First: first, Second: second}]
+}
+
+// KerningMap returns the adjustments of Kernings, indexed by their pair of characters.
+// If a pair is listed more than once, the first amount applies.
+func (font *Font) KerningMap() map[KerningPair]int {
+	pairs := make(map[KerningPair]int, len(font.Kernings))
+	for _, kerning := range font.Kernings {
+		pair := KerningPair{First: kerning.First, Second: kerning.Second}
+		if _, known := pairs[pair]; !known {
+			pairs[pair] = kerning.Amount
+		}
+	}
+	return pairs
+}
+
+func (font *Font) validated() (*Font, error) {
+	if font.LineHeight <= 0 {
+		return nil, FormatError{Reason: "missing line height"}
+	}
+	for _, char := range font.Chars {
+		if (char.Page < 0) || (char.Page >= len(font.Pages)) {
+			return nil, FormatError{Reason: fmt.Sprintf("character %d refers to unknown page %d", char.ID, char.Page)}
+		}
+		if (char.X < 0) || (char.Y < 0) || (char.Width < 0) || (char.Height < 0) {
+			return nil, FormatError{Reason: fmt.Sprintf("character %d has invalid bounds", char.ID)}
+		}
+	}
+	return font, nil
+}
@@ -0,0 +1,225 @@
+package bmfont_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/ianling/imgui-go"
+	"github.com/ianling/imgui-go/bmfont"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const textSample = `info face="Pixel Font" size=-12 bold=1 italic=0 charset="" unicode=1 padding=1,2,3,4 spacing=1,1
+common lineHeight=14 base=11 scaleW=16 scaleH=8 pages=1 packed=0
+page id=0 file="pixel_0.png"
+chars count=2
+char id=32   x=0  y=0  width=0  height=0  xoffset=0  yoffset=11 xadvance=4  page=0 chnl=15
+char id=65   x=2  y=1  width=3  height=4  xoffset=1  yoffset=-2 xadvance=5  page=0 chnl=8
+kernings count=1
+kerning first=65 second=32 amount=-1
+`
+
+const xmlSample = `<?xml version="1.0"?>
+<font>
+  <info face="Pixel Font" size="-12" bold="1" italic="0" charset="" unicode="1" padding="1,2,3,4" spacing="1,1"/>
+  <common lineHeight="14" base="11" scaleW="16" scaleH="8" pages="1" packed="0"/>
+  <pages>
+    <page id="0" file="pixel_0.png" />
+  </pages>
+  <chars count="2">
+    <char id="32" x="0" y="0" width="0" height="0" xoffset="0" yoffset="11" xadvance="4" page="0" chnl="15" />
+    <char id="65" x="2" y="1" width="3" height="4" xoffset="1" yoffset="-2" xadvance="5" page="0" chnl="8" />
+  </chars>
+  <kernings count="1">
+    <kerning first="65" second="32" amount="-1" />
+  </kernings>
+</font>
+`
+
+func writeLittleEndian(buffer *bytes.Buffer, values ...interface{}) {
+	for _, value := range values {
+		_ = binary.Write(buffer, binary.LittleEndian, value)
+	}
+}
+
+func writeBlock(buffer *bytes.Buffer, blockType uint8, content func(block *bytes.Buffer)) {
+	var block bytes.Buffer
+	content(&block)
+	writeLittleEndian(buffer, blockType, uint32(block.Len()))
+	buffer.Write(block.Bytes())
+}
+
+func binarySample() []byte {
+	var buffer bytes.Buffer
+	buffer.WriteString("BMF")
+	writeLittleEndian(&buffer, uint8(3))
+	writeBlock(&buffer, 1, func(block *bytes.Buffer) {
+		writeLittleEndian(block, int16(-12), uint8(1<<1|1<<3), uint8(0), uint16(100), uint8(1),
+			[4]uint8{1, 2, 3, 4}, [2]uint8{1, 1}, uint8(0))
+		block.WriteString("Pixel Font\x00")
+	})
+	writeBlock(&buffer, 2, func(block *bytes.Buffer) {
+		writeLittleEndian(block, uint16(14), uint16(11), uint16(16), uint16(8), uint16(1), uint8(0), [4]uint8{})
+	})
+	writeBlock(&buffer, 3, func(block *bytes.Buffer) { block.WriteString("pixel_0.png\x00") })
+	writeBlock(&buffer, 4, func(block *bytes.Buffer) {
+		writeLittleEndian(block, uint32(32), uint16(0), uint16(0), uint16(0), uint16(0),
+			int16(0), int16(11), int16(4), uint8(0), uint8(15))
+		writeLittleEndian(block, uint32(65), uint16(2), uint16(1), uint16(3), uint16(4),
+			int16(1), int16(-2), int16(5), uint8(0), uint8(8))
+	})
+	writeBlock(&buffer, 5, func(block *bytes.Buffer) {
+		writeLittleEndian(block, uint32(65), uint32(32), int16(-1))
+	})
+	return buffer.Bytes()
+}
+
+func expectedFont() *bmfont.Font {
+	return &bmfont.Font{
+		Face:       "Pixel Font",
+		Size:       -12,
+		Bold:       true,
+		Padding:    [4]int{1, 2, 3, 4},
+		Spacing:    [2]int{1, 1},
+		LineHeight: 14,
+		Base:       11,
+		ScaleW:     16,
+		ScaleH:     8,
+		Pages:      []string{"pixel_0.png"},
+		Chars: []bmfont.Char{
+			{ID: ' ', YOffset: 11, XAdvance: 4, Channel: bmfont.ChannelAll},
+			{ID: 'A', X: 2, Y: 1, Width: 3, Height: 4, XOffset: 1, YOffset: -2, XAdvance: 5, Channel: bmfont.ChannelAlpha},
+		},
+		Kernings: []bmfont.Kerning{{First: 'A', Second: ' ', Amount: -1}},
+	}
+}
+
+func TestParseFormats(t *testing.T) {
+	tt := []struct {
+		name string
+		data []byte
+	}{
+		{name: "text", data: []byte(textSample)},
+		{name: "xml", data: []byte(xmlSample)},
+		{name: "binary", data: binarySample()},
+	}
+	for _, tc := range tt {
+		td := tc
+		t.Run(td.name, func(t *testing.T) {
+			font, err := bmfont.Parse(td.data)
+			require.Nil(t, err)
+			assert.Equal(t, expectedFont(), font)
+			assert.Equal(t, -1, font.Kerning('A', ' '))
+			assert.Equal(t, 0, font.Kerning(' ', 'A'))
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tt := []struct {
+		name string
+		data string
+	}{
+		{name: "unknown tag", data: "common lineHeight=10\nunknown a=1\n"},
+		{name: "bad number", data: "common lineHeight=ten\n"},
+		{name: "missing line height", data: "info face=x\n"},
+		{name: "unknown page", data: "common lineHeight=10\nchar id=65 page=1\n"},
+		{name: "binary version", data: "BMF\x02"},
+		{name: "truncated binary", data: "BMF\x03\x01\xFF\x00\x00\x00"},
+	}
+	for _, tc := range tt {
+		td := tc
+		t.Run(td.name, func(t *testing.T) {
+			_, err := bmfont.Parse([]byte(td.data))
+			assert.IsType(t, bmfont.FormatError{}, err, "Error should be of invalid format: %v", err)
+		})
+	}
+}
+
+func TestAddToAtlas(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+	atlas := imgui.CurrentIO().Fonts()
+	font, err := bmfont.Parse([]byte(textSample))
+	require.Nil(t, err)
+	page := image.NewNRGBA(image.Rect(0, 0, 16, 8))
+	page.SetNRGBA(2, 1, color.NRGBA{R: 10, G: 20, B: 30, A: 200})
+
+	added := font.AddToAtlas(atlas, []image.Image{page})
+	require.True(t, atlas.Build())
+	texture := added.Rasterize()
+
+	imguiFont := added.Font()
+	assert.Equal(t, float32(14), imguiFont.FontSize())
+	assert.Equal(t, float32(5), imguiFont.CharAdvance('A'))
+	assert.Equal(t, float32(4), imguiFont.CharAdvance(' '))
+
+	glyphRect := customRectOfGlyph(atlas, 'A')
+	require.True(t, glyphRect.IsPacked())
+	assert.Equal(t, 3, glyphRect.Width)
+	assert.Equal(t, 4, glyphRect.Height)
+	pixels := (*[1 << 30]byte)(texture.Pixels)
+	offset := (glyphRect.Y*texture.Width + glyphRect.X) * 4
+	assert.Equal(t, []byte{0xFF, 0xFF, 0xFF, 200}, pixels[offset:offset+4], "Alpha channel glyph should be white")
+	assert.Equal(t, []byte{0xFF, 0xFF, 0xFF, 0}, pixels[offset+4:offset+8])
+}
+
+func TestRasterizeReadsPagesRelativeToTheirBounds(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+	atlas := imgui.CurrentIO().Fonts()
+	font, err := bmfont.Parse([]byte(textSample))
+	require.Nil(t, err)
+	sheet := image.NewNRGBA(image.Rect(0, 0, 32, 16))
+	sheet.SetNRGBA(16+2, 8+1, color.NRGBA{R: 10, G: 20, B: 30, A: 200})
+	page := sheet.SubImage(image.Rect(16, 8, 32, 16))
+
+	added := font.AddToAtlas(atlas, []image.Image{page})
+	require.True(t, atlas.Build())
+	texture := added.Rasterize()
+
+	glyphRect := customRectOfGlyph(atlas, 'A')
+	require.True(t, glyphRect.IsPacked())
+	pixels := (*[1 << 30]byte)(texture.Pixels)
+	offset := (glyphRect.Y*texture.Width + glyphRect.X) * 4
+	assert.Equal(t, []byte{0xFF, 0xFF, 0xFF, 200}, pixels[offset:offset+4], "Glyph should be read from the sub-image")
+}
+
+func customRectOfGlyph(atlas imgui.FontAtlas, glyph rune) imgui.FontAtlasCustomRect {
+	var glyphRect imgui.FontAtlasCustomRect
+	for index := 0; index < atlas.CustomRectCount(); index++ {
+		if rect := atlas.CustomRectByIndex(index); rect.GlyphID == glyph {
+			glyphRect = rect
+		}
+	}
+	return glyphRect
+}
+
+var _ imgui.FontAtlasRasterizer = (*bmfont.AtlasFont)(nil)
+
+func TestAtlasFontAppliesKerning(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+	io := imgui.CurrentIO()
+	io.SetIniFilename("")
+	io.SetDisplaySize(imgui.Vec2{X: 400, Y: 300})
+	font, err := bmfont.Parse([]byte(textSample))
+	require.Nil(t, err)
+	added := font.AddToAtlas(io.Fonts(), nil)
+	added.Rasterize()
+
+	assert.Equal(t, imgui.Vec2{X: 13, Y: 14}, added.CalcTextSize("A A"), "Pair 'A ' should be one pixel closer")
+	assert.Equal(t, imgui.Vec2{X: 8, Y: 28}, added.CalcTextSize("A \nA"))
+
+	imgui.NewFrame()
+	imgui.Begin("Kerning")
+	added.Text("A A")
+	assert.Equal(t, imgui.Vec2{X: 13, Y: 14}, imgui.ItemRectMax().Minus(imgui.ItemRectMin()))
+	imgui.End()
+	imgui.Render()
+}
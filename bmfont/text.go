@@ -0,0 +1,191 @@
+package bmfont
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// textAttributes are the key/value pairs of a single line of the text format.
+type textAttributes map[string]string
+
+func (attributes textAttributes) int(key string) (int, error) {
+	value, present := attributes[key]
+	if !present {
+		return 0, nil
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, FormatError{Reason: fmt.Sprintf("attribute %s: %v", key, err)}
+	}
+	return number, nil
+}
+
+func (attributes textAttributes) ints(key string, values []int) error {
+	value, present := attributes[key]
+	if !present {
+		return nil
+	}
+	parts := strings.Split(value, ",")
+	if len(parts) != len(values) {
+		return FormatError{Reason: fmt.Sprintf("attribute %s: expected %d values", key, len(values))}
+	}
+	for index, part := range parts {
+		number, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return FormatError{Reason: fmt.Sprintf("attribute %s: %v", key, err)}
+		}
+		values[index] = number
+	}
+	return nil
+}
+
+// intsInto reads the integer attributes of given keys into the targets, stopping at the first error.
+func (attributes textAttributes) intsInto(targets map[string]*int) error {
+	for key, target := range targets {
+		value, err := attributes.int(key)
+		if err != nil {
+			return err
+		}
+		*target = value
+	}
+	return nil
+}
+
+// splitTextLine returns the tag of the line and its attributes. Values may be quoted.
+func splitTextLine(line string) (string, textAttributes, error) {
+	attributes := make(textAttributes)
+	line = strings.TrimSpace(line)
+	end := strings.IndexAny(line, " \t")
+	if end < 0 {
+		return line, attributes, nil
+	}
+	tag := line[:end]
+	rest := line[end:]
+	for {
+		rest = strings.TrimLeft(rest, " \t")
+		if rest == "" {
+			return tag, attributes, nil
+		}
+		equals := strings.IndexByte(rest, '=')
+		if equals < 0 {
+			return "", nil, FormatError{Reason: fmt.Sprintf("missing value in %q", line)}
+		}
+		key := rest[:equals]
+		rest = rest[equals+1:]
+		var value string
+		if strings.HasPrefix(rest, "\"") {
+			closing := strings.IndexByte(rest[1:], '"')
+			if closing < 0 {
+				return "", nil, FormatError{Reason: fmt.Sprintf("unterminated quote in %q", line)}
+			}
+			value = rest[1 : closing+1]
+			rest = rest[closing+2:]
+		} else {
+			valueEnd := strings.IndexAny(rest, " \t")
+			if valueEnd < 0 {
+				valueEnd = len(rest)
+			}
+			value = rest[:valueEnd]
+			rest = rest[valueEnd:]
+		}
+		attributes[key] = value
+	}
+}
+
+func parseText(data []byte) (*Font, error) {
+	font := &Font{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		tag, attributes, err := splitTextLine(scanner.Text())
+		if err != nil {
+			return nil, err
+		}
+		err = font.applyTag(tag, attributes)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return font.validated()
+}
+
+// applyTag adds the attributes of given tag to the font. The tags and attributes are the same for the text
+// and the XML format.
+func (font *Font) applyTag(tag string, attributes textAttributes) (err error) {
+	switch tag {
+	case "info":
+		err = font.applyInfo(attributes)
+	case "common":
+		err = attributes.intsInto(map[string]*int{
+			"lineHeight": &font.LineHeight, "base": &font.Base, "scaleW": &font.ScaleW, "scaleH": &font.ScaleH,
+		})
+	case "page":
+		err = font.applyPage(attributes)
+	case "char":
+		var char Char
+		err = char.apply(attributes)
+		font.Chars = append(font.Chars, char)
+	case "kerning":
+		var kerning Kerning
+		err = kerning.apply(attributes)
+		font.Kernings = append(font.Kernings, kerning)
+	case "", "font", "pages", "chars", "kernings":
+	default:
+		err = FormatError{Reason: fmt.Sprintf("unknown tag %q", tag)}
+	}
+	return err
+}
+
+func (font *Font) applyInfo(attributes textAttributes) error {
+	font.Face = attributes["face"]
+	var bold, italic int
+	err := attributes.intsInto(map[string]*int{"size": &font.Size, "bold": &bold, "italic": &italic})
+	if err == nil {
+		err = attributes.ints("padding", font.Padding[:])
+	}
+	if err == nil {
+		err = attributes.ints("spacing", font.Spacing[:])
+	}
+	font.Bold = bold != 0
+	font.Italic = italic != 0
+	return err
+}
+
+func (font *Font) applyPage(attributes textAttributes) error {
+	id, err := attributes.int("id")
+	if err != nil {
+		return err
+	}
+	if (id < 0) || (id > 0xFF) {
+		return FormatError{Reason: fmt.Sprintf("invalid page id %d", id)}
+	}
+	for len(font.Pages) <= id {
+		font.Pages = append(font.Pages, "")
+	}
+	font.Pages[id] = attributes["file"]
+	return nil
+}
+
+func (char *Char) apply(attributes textAttributes) error {
+	var id int
+	err := attributes.intsInto(map[string]*int{
+		"id": &id, "x": &char.X, "y": &char.Y, "width": &char.Width, "height": &char.Height,
+		"xoffset": &char.XOffset, "yoffset": &char.YOffset, "xadvance": &char.XAdvance,
+		"page": &char.Page, "chnl": &char.Channel,
+	})
+	char.ID = rune(id)
+	return err
+}
+
+func (kerning *Kerning) apply(attributes textAttributes) error {
+	var first, second int
+	err := attributes.intsInto(map[string]*int{"first": &first, "second": &second, "amount": &kerning.Amount})
+	kerning.First = rune(first)
+	kerning.Second = rune(second)
+	return err
+}
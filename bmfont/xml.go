@@ -0,0 +1,33 @@
+package bmfont
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+)
+
+func parseXML(data []byte) (*Font, error) {
+	font := &Font{}
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, FormatError{Reason: err.Error()}
+		}
+		element, isStart := token.(xml.StartElement)
+		if !isStart {
+			continue
+		}
+		attributes := make(textAttributes)
+		for _, attr := range element.Attr {
+			attributes[attr.Name.Local] = attr.Value
+		}
+		if err = font.applyTag(element.Name.Local, attributes); err != nil {
+			return nil, err
+		}
+	}
+	return font.validated()
+}
@@ -0,0 +1,177 @@
+package fontconfig
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ianling/imgui-go"
+)
+
+// Fallback describes a face that is merged into the primary face of a Chain,
+// if the faces before it do not cover the characters of its sample.
+type Fallback struct {
+	// Query selects the fallback face. The characters of the sample that are missing are added to it.
+	Query Query
+	// Sample contains characters that are representative of the script the fallback is meant for.
+	Sample string
+	// GlyphRanges returns the glyph ranges to load from the fallback face.
+	// If nil, the ranges of the sample are used.
+	GlyphRanges func(atlas imgui.FontAtlas) imgui.GlyphRanges
+}
+
+var cjkGlyphRanges struct {
+	once   sync.Once
+	ranges imgui.GlyphRanges
+}
+
+// FallbackCJK merges a face for Chinese, Japanese and Korean text.
+// It loads the common simplified Chinese ideographs, as well as Japanese and Korean characters.
+// Use a DynamicFontAtlas instead to load only the characters that are actually displayed.
+var FallbackCJK = Fallback{
+	Query:  Query{Family: "sans-serif", Lang: "zh-cn"},
+	Sample: "中文字体かなカナ한글",
+	GlyphRanges: func(atlas imgui.FontAtlas) imgui.GlyphRanges {
+		cjkGlyphRanges.once.Do(func() {
+			builder := imgui.NewFontGlyphRangesBuilder()
+			builder.AddRanges(atlas.GlyphRangesChineseSimplifiedCommon())
+			builder.AddRanges(atlas.GlyphRangesJapanese())
+			builder.AddRanges(atlas.GlyphRangesKorean())
+			ranges := imgui.NewGlyphRanges()
+			builder.BuildRanges(ranges)
+			cjkGlyphRanges.ranges = ranges.Data()
+		})
+		return cjkGlyphRanges.ranges
+	},
+}
+
+// FallbackEmoji merges a face for emoji and pictographic symbols.
+// Emoji beyond U+FFFF are only loaded if imgui is built with 32-bit characters, and color emoji are only rendered
+// in color with the FreeType font builder.
+var FallbackEmoji = Fallback{
+	Query:  Query{Family: "emoji"},
+	Sample: "☀☺✂✈",
+	GlyphRanges: func(imgui.FontAtlas) imgui.GlyphRanges {
+		return emojiGlyphRanges()
+	},
+}
+
+var emojiRanges struct {
+	once   sync.Once
+	ranges imgui.GlyphRanges
+}
+
+func emojiGlyphRanges() imgui.GlyphRanges {
+	emojiRanges.once.Do(func() {
+		builder := imgui.NewFontGlyphRangesBuilder()
+		builder.AddRange(0x2300, 0x23FF)
+		builder.AddRange(0x2600, 0x27BF)
+		builder.AddRange(0x2B00, 0x2BFF)
+		builder.AddRange(0x1F000, 0x1FAFF)
+		ranges := imgui.NewGlyphRanges()
+		builder.BuildRanges(ranges)
+		emojiRanges.ranges = ranges.Data()
+	})
+	return emojiRanges.ranges
+}
+
+// ChainFace is a face of a Chain.
+type ChainFace struct {
+	Face
+	// GlyphRanges returns the glyph ranges to load from the face. It is nil for the default ranges.
+	GlyphRanges func(atlas imgui.FontAtlas) imgui.GlyphRanges
+}
+
+// Chain is a primary face with the fallback faces that are merged into it.
+type Chain []ChainFace
+
+// Resolve finds the face for the primary query, followed by those fallbacks that are needed.
+// A fallback is needed if the faces before it lack some of the characters of its sample,
+// and it is only added if its face covers at least one of the missing characters.
+func Resolve(primary Query, fallbacks ...Fallback) (Chain, error) {
+	face, err := Match(primary)
+	if err != nil {
+		return nil, err
+	}
+	chain := Chain{{Face: face}}
+	for _, fallback := range fallbacks {
+		missing := chain.missing(fallback.Sample)
+		if len(missing) == 0 {
+			continue
+		}
+		query := fallback.Query
+		query.Chars += missing
+		fallbackFace, err := Match(query)
+		if err == ErrNotFound {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if !fallbackFace.coversAny(missing) {
+			continue
+		}
+		glyphRanges := fallback.GlyphRanges
+		if glyphRanges == nil {
+			sampleRanges := sampleGlyphRanges(fallback.Sample)
+			glyphRanges = func(imgui.FontAtlas) imgui.GlyphRanges { return sampleRanges }
+		}
+		chain = append(chain, ChainFace{Face: fallbackFace, GlyphRanges: glyphRanges})
+	}
+	return chain, nil
+}
+
+func (face Face) coversAny(text string) bool {
+	for _, c := range text {
+		if face.Coverage.Contains(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// missing returns the characters of the text that are not covered by any face of the chain.
+func (chain Chain) missing(text string) string {
+	var missing []rune
+	for _, c := range text {
+		covered := false
+		for _, face := range chain {
+			covered = covered || face.Coverage.Contains(c)
+		}
+		if !covered {
+			missing = append(missing, c)
+		}
+	}
+	return string(missing)
+}
+
+func sampleGlyphRanges(sample string) imgui.GlyphRanges {
+	builder := imgui.NewFontGlyphRangesBuilder()
+	builder.AddText(sample)
+	ranges := imgui.NewGlyphRanges()
+	builder.BuildRanges(ranges)
+	return ranges.Data()
+}
+
+// AddToAtlas adds the faces of the chain to the atlas, merging the fallbacks into the primary face.
+// It returns the font of the primary face.
+func (chain Chain) AddToAtlas(atlas imgui.FontAtlas, sizePixels float32) (imgui.Font, error) {
+	var primary imgui.Font
+	for i, face := range chain {
+		config := imgui.NewFontConfig()
+		config.SetFontNo(face.Index)
+		config.SetMergeMode(i > 0)
+		config.SetName(face.Family + " " + face.Style)
+		glyphRanges := imgui.EmptyGlyphRanges
+		if face.GlyphRanges != nil {
+			glyphRanges = face.GlyphRanges(atlas)
+		}
+		font := atlas.AddFontFromFileTTFV(face.File, sizePixels, config, glyphRanges)
+		config.Delete()
+		if font == imgui.DefaultFont {
+			return imgui.DefaultFont, fmt.Errorf("fontconfig: failed to load font file %q", face.File)
+		}
+		if i == 0 {
+			primary = font
+		}
+	}
+	return primary, nil
+}
@@ -0,0 +1,217 @@
+// Package fontconfig discovers fonts that are installed on the system, using fontconfig as it is available on Linux.
+//
+// Fonts are queried with the fc-match tool of fontconfig, which has to be installed.
+// The found faces can be added to an imgui font atlas, and a Chain merges fallback faces, such as for CJK characters
+// or emoji, only if the primary face does not cover them already:
+//   chain, err := fontconfig.Resolve(fontconfig.Query{Family: "sans-serif"}, fontconfig.FallbackCJK)
+//   if err == nil {
+//       font, err = chain.AddToAtlas(imgui.CurrentIO().Fonts(), 16)
+//   }
+package fontconfig
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrNotAvailable is returned if fontconfig is not installed.
+var ErrNotAvailable = errors.New("fontconfig: fc-match is not available")
+
+// ErrNotFound is returned if no font matches a query.
+var ErrNotFound = errors.New("fontconfig: no matching font found")
+
+// Query describes the font to look for. Empty fields are not considered.
+type Query struct {
+	// Family is the name of a font family, such as "Noto Sans", or a generic one, such as "sans-serif" or "monospace".
+	Family string
+	// Style is the name of a style within the family, such as "Regular" or "Bold Italic".
+	Style string
+	// Lang is a language the font must support, as RFC-3066 code, such as "ja" or "zh-cn".
+	Lang string
+	// Chars are characters the font should cover.
+	Chars string
+}
+
+// Pattern returns the query as fontconfig pattern, as used by fc-match.
+func (query Query) Pattern() string {
+	var pattern strings.Builder
+	pattern.WriteString(escapePatternValue(query.Family))
+	if len(query.Style) > 0 {
+		pattern.WriteString(":style=" + escapePatternValue(query.Style))
+	}
+	if len(query.Lang) > 0 {
+		pattern.WriteString(":lang=" + escapePatternValue(query.Lang))
+	}
+	if len(query.Chars) > 0 {
+		pattern.WriteString(":charset=" + CoverageOf(query.Chars).String())
+	}
+	return pattern.String()
+}
+
+func escapePatternValue(value string) string {
+	var escaped strings.Builder
+	for _, c := range value {
+		if strings.ContainsRune(`\-:,=`, c) {
+			escaped.WriteRune('\\')
+		}
+		escaped.WriteRune(c)
+	}
+	return escaped.String()
+}
+
+// Face is a font face that was found on the system.
+type Face struct {
+	// Family is the name of the font family.
+	Family string
+	// Style is the name of the style within the family.
+	Style string
+	// File is the path of the font file.
+	File string
+	// Index is the index of the face within a font collection file (.ttc), or zero for other files.
+	Index int
+	// Coverage are the characters the face has glyphs for.
+	Coverage Coverage
+}
+
+// ReadFile returns the contents of the font file.
+func (face Face) ReadFile() ([]byte, error) {
+	return ioutil.ReadFile(face.File)
+}
+
+// Covers returns true if the face has glyphs for all characters of the text.
+func (face Face) Covers(text string) bool {
+	for _, c := range text {
+		if !face.Coverage.Contains(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// faceFormat lets fc-match print one face per line, with fields separated by tabs.
+const faceFormat = `%{family[0]}\t%{style[0]}\t%{file}\t%{index}\t%{charset}\n`
+
+// Match returns the face that matches the query best.
+// fontconfig substitutes missing families, so the returned face may belong to a different family than requested.
+func Match(query Query) (Face, error) {
+	faces, err := runFcMatch("-f", faceFormat, query.Pattern())
+	if err != nil {
+		return Face{}, err
+	}
+	return faces[0], nil
+}
+
+// Sort returns all faces that match the query, ordered from best to worst match.
+func Sort(query Query) ([]Face, error) {
+	return runFcMatch("-s", "-f", faceFormat, query.Pattern())
+}
+
+func runFcMatch(args ...string) ([]Face, error) {
+	path, err := exec.LookPath("fc-match")
+	if err != nil {
+		return nil, ErrNotAvailable
+	}
+	var stderr bytes.Buffer
+	cmd := exec.Command(path, args...) // nolint: gosec
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("fontconfig: fc-match failed: %v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	var faces []Face
+	for _, line := range strings.Split(string(output), "\n") {
+		fields := strings.Split(line, "\t")
+		if (len(fields) != 5) || (len(fields[2]) == 0) {
+			continue
+		}
+		index, _ := strconv.Atoi(fields[3])
+		coverage, err := ParseCoverage(fields[4])
+		if err != nil {
+			return nil, err
+		}
+		faces = append(faces, Face{
+			Family:   fields[0],
+			Style:    fields[1],
+			File:     fields[2],
+			Index:    index,
+			Coverage: coverage,
+		})
+	}
+	if len(faces) == 0 {
+		return nil, ErrNotFound
+	}
+	return faces, nil
+}
+
+// CoverageRange is a range of characters, from First to Last, inclusive.
+type CoverageRange struct {
+	First, Last rune
+}
+
+// Coverage is a set of characters, as sorted, non-overlapping ranges.
+type Coverage []CoverageRange
+
+// ParseCoverage parses a charset as printed by fontconfig, such as "20-7e a0-17f 192".
+func ParseCoverage(charset string) (Coverage, error) {
+	var coverage Coverage
+	for _, field := range strings.Fields(charset) {
+		bounds := strings.SplitN(field, "-", 2)
+		first, err := strconv.ParseUint(bounds[0], 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("fontconfig: invalid charset range %q", field)
+		}
+		last := first
+		if len(bounds) > 1 {
+			last, err = strconv.ParseUint(bounds[1], 16, 32)
+			if (err != nil) || (last < first) {
+				return nil, fmt.Errorf("fontconfig: invalid charset range %q", field)
+			}
+		}
+		coverage = append(coverage, CoverageRange{First: rune(first), Last: rune(last)})
+	}
+	sort.Slice(coverage, func(i, j int) bool { return coverage[i].First < coverage[j].First })
+	return coverage, nil
+}
+
+// CoverageOf returns the coverage of the characters of the text.
+func CoverageOf(text string) Coverage {
+	chars := []rune(text)
+	sort.Slice(chars, func(i, j int) bool { return chars[i] < chars[j] })
+	var coverage Coverage
+	for _, c := range chars {
+		last := len(coverage) - 1
+		switch {
+		case (last >= 0) && (c <= coverage[last].Last):
+		case (last >= 0) && (c == coverage[last].Last+1):
+			coverage[last].Last = c
+		default:
+			coverage = append(coverage, CoverageRange{First: c, Last: c})
+		}
+	}
+	return coverage
+}
+
+// Contains returns true if the character is covered.
+func (coverage Coverage) Contains(c rune) bool {
+	i := sort.Search(len(coverage), func(i int) bool { return coverage[i].Last >= c })
+	return (i < len(coverage)) && (coverage[i].First <= c)
+}
+
+// String returns the coverage in the format of fontconfig.
+func (coverage Coverage) String() string {
+	parts := make([]string, len(coverage))
+	for i, r := range coverage {
+		if r.First == r.Last {
+			parts[i] = strconv.FormatInt(int64(r.First), 16)
+		} else {
+			parts[i] = strconv.FormatInt(int64(r.First), 16) + "-" + strconv.FormatInt(int64(r.Last), 16)
+		}
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,81 @@
+package fontconfig_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/ianling/imgui-go/fontconfig"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryPattern(t *testing.T) {
+	query := fontconfig.Query{Family: "sans-serif", Style: "Bold", Lang: "zh-cn", Chars: "ba"}
+	assert.Equal(t, `sans\-serif:style=Bold:lang=zh\-cn:charset=61-62`, query.Pattern())
+}
+
+func TestParseCoverage(t *testing.T) {
+	coverage, err := fontconfig.ParseCoverage("a0-17f 20-7e 192")
+	require.Nil(t, err)
+	assert.Equal(t, "20-7e a0-17f 192", coverage.String())
+	assert.True(t, coverage.Contains('A'))
+	assert.True(t, coverage.Contains(0x192))
+	assert.False(t, coverage.Contains(0x7f))
+	assert.False(t, coverage.Contains(0x4E00))
+
+	_, err = fontconfig.ParseCoverage("zz")
+	assert.NotNil(t, err)
+}
+
+func TestCoverageOf(t *testing.T) {
+	assert.Equal(t, "61-63 78", fontconfig.CoverageOf("xcabba").String())
+}
+
+func TestResolve(t *testing.T) {
+	chain, err := fontconfig.Resolve(fontconfig.Query{Family: "sans-serif"}, fontconfig.FallbackCJK)
+	if err == fontconfig.ErrNotAvailable {
+		t.Skip("fontconfig is not installed")
+	}
+	require.Nil(t, err)
+	require.True(t, len(chain) > 0)
+	assert.NotEmpty(t, chain[0].File)
+}
+
+func installFakeFcMatch(t *testing.T, script string) (restore func()) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake fc-match requires a shell")
+	}
+	dir, err := ioutil.TempDir("", "fontconfig")
+	require.Nil(t, err)
+	err = ioutil.WriteFile(filepath.Join(dir, "fc-match"), []byte("#!/bin/sh\n"+script), 0700) // nolint: gosec
+	require.Nil(t, err)
+	oldPath := os.Getenv("PATH")
+	_ = os.Setenv("PATH", dir+string(os.PathListSeparator)+oldPath)
+	return func() {
+		_ = os.Setenv("PATH", oldPath)
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func TestResolveAddsOnlyNeededFallbacks(t *testing.T) {
+	restore := installFakeFcMatch(t, `
+case "$3" in
+*lang*) printf 'Noto Sans CJK SC\tRegular\t/fonts/NotoSansCJK.ttc\t2\t20-7e 3041-30ff 4e00-9fff\n' ;;
+*emoji*) printf 'Noto Color Emoji\tRegular\t/fonts/NotoColorEmoji.ttf\t0\t2600-27bf\n' ;;
+*) printf 'DejaVu Sans\tBook\t/fonts/DejaVuSans.ttf\t0\t20-7e a0-17f 2600-27bf\n' ;;
+esac
+`)
+	defer restore()
+
+	chain, err := fontconfig.Resolve(fontconfig.Query{Family: "sans-serif"}, fontconfig.FallbackCJK, fontconfig.FallbackEmoji)
+	require.Nil(t, err)
+	require.Len(t, chain, 2, "Emoji are covered by the primary face")
+	assert.Equal(t, "DejaVu Sans", chain[0].Family)
+	assert.Equal(t, "/fonts/NotoSansCJK.ttc", chain[1].File)
+	assert.Equal(t, 2, chain[1].Index)
+	assert.True(t, chain[1].Covers("中文"))
+}
@@ -0,0 +1,117 @@
+package iconfont
+
+// Codepoints of commonly used icons of Font Awesome 5, in the solid style (fa-solid-900.ttf).
+// Other icons of the font can be used with their codepoint as Icon(0xF...), within the range of FontAwesome5.
+const (
+	FontAwesomeArrowDown           Icon = 0xF063
+	FontAwesomeArrowLeft           Icon = 0xF060
+	FontAwesomeArrowRight          Icon = 0xF061
+	FontAwesomeArrowUp             Icon = 0xF062
+	FontAwesomeBackward            Icon = 0xF04A
+	FontAwesomeBars                Icon = 0xF0C9
+	FontAwesomeBell                Icon = 0xF0F3
+	FontAwesomeBold                Icon = 0xF032
+	FontAwesomeBook                Icon = 0xF02D
+	FontAwesomeBookmark            Icon = 0xF02E
+	FontAwesomeBug                 Icon = 0xF188
+	FontAwesomeCalendar            Icon = 0xF133
+	FontAwesomeCamera              Icon = 0xF030
+	FontAwesomeCheck               Icon = 0xF00C
+	FontAwesomeCheckCircle         Icon = 0xF058
+	FontAwesomeCheckSquare         Icon = 0xF14A
+	FontAwesomeChevronDown         Icon = 0xF078
+	FontAwesomeChevronLeft         Icon = 0xF053
+	FontAwesomeChevronRight        Icon = 0xF054
+	FontAwesomeChevronUp           Icon = 0xF077
+	FontAwesomeCircle              Icon = 0xF111
+	FontAwesomeClipboard           Icon = 0xF328
+	FontAwesomeClock               Icon = 0xF017
+	FontAwesomeCloud               Icon = 0xF0C2
+	FontAwesomeCode                Icon = 0xF121
+	FontAwesomeCog                 Icon = 0xF013
+	FontAwesomeCogs                Icon = 0xF085
+	FontAwesomeComments            Icon = 0xF086
+	FontAwesomeCompress            Icon = 0xF066
+	FontAwesomeCopy                Icon = 0xF0C5
+	FontAwesomeCut                 Icon = 0xF0C4
+	FontAwesomeDatabase            Icon = 0xF1C0
+	FontAwesomeDesktop             Icon = 0xF108
+	FontAwesomeDownload            Icon = 0xF019
+	FontAwesomeEdit                Icon = 0xF044
+	FontAwesomeEnvelope            Icon = 0xF0E0
+	FontAwesomeExclamation         Icon = 0xF12A
+	FontAwesomeExclamationCircle   Icon = 0xF06A
+	FontAwesomeExclamationTriangle Icon = 0xF071
+	FontAwesomeExpand              Icon = 0xF065
+	FontAwesomeEye                 Icon = 0xF06E
+	FontAwesomeEyeSlash            Icon = 0xF070
+	FontAwesomeFile                Icon = 0xF15B
+	FontAwesomeFileAlt             Icon = 0xF15C
+	FontAwesomeFilter              Icon = 0xF0B0
+	FontAwesomeFlag                Icon = 0xF024
+	FontAwesomeFolder              Icon = 0xF07B
+	FontAwesomeFolderOpen          Icon = 0xF07C
+	FontAwesomeFont                Icon = 0xF031
+	FontAwesomeForward             Icon = 0xF04E
+	FontAwesomeGlobe               Icon = 0xF0AC
+	FontAwesomeHeadphones          Icon = 0xF025
+	FontAwesomeHeart               Icon = 0xF004
+	FontAwesomeHome                Icon = 0xF015
+	FontAwesomeImage               Icon = 0xF03E
+	FontAwesomeInbox               Icon = 0xF01C
+	FontAwesomeInfo                Icon = 0xF129
+	FontAwesomeInfoCircle          Icon = 0xF05A
+	FontAwesomeItalic              Icon = 0xF033
+	FontAwesomeKey                 Icon = 0xF084
+	FontAwesomeKeyboard            Icon = 0xF11C
+	FontAwesomeLink                Icon = 0xF0C1
+	FontAwesomeList                Icon = 0xF03A
+	FontAwesomeLock                Icon = 0xF023
+	FontAwesomeMinus               Icon = 0xF068
+	FontAwesomeMinusCircle         Icon = 0xF056
+	FontAwesomePaste               Icon = 0xF0EA
+	FontAwesomePause               Icon = 0xF04C
+	FontAwesomePen                 Icon = 0xF304
+	FontAwesomePlay                Icon = 0xF04B
+	FontAwesomePlus                Icon = 0xF067
+	FontAwesomePlusCircle          Icon = 0xF055
+	FontAwesomePowerOff            Icon = 0xF011
+	FontAwesomePrint               Icon = 0xF02F
+	FontAwesomeQuestion            Icon = 0xF128
+	FontAwesomeQuestionCircle      Icon = 0xF059
+	FontAwesomeRedo                Icon = 0xF01E
+	FontAwesomeSave                Icon = 0xF0C7
+	FontAwesomeSearch              Icon = 0xF002
+	FontAwesomeSearchMinus         Icon = 0xF010
+	FontAwesomeSearchPlus          Icon = 0xF00E
+	FontAwesomeServer              Icon = 0xF233
+	FontAwesomeSort                Icon = 0xF0DC
+	FontAwesomeSortAmountDown      Icon = 0xF160
+	FontAwesomeSpinner             Icon = 0xF110
+	FontAwesomeSquare              Icon = 0xF0C8
+	FontAwesomeStar                Icon = 0xF005
+	FontAwesomeStop                Icon = 0xF04D
+	FontAwesomeSync                Icon = 0xF021
+	FontAwesomeTag                 Icon = 0xF02B
+	FontAwesomeTerminal            Icon = 0xF120
+	FontAwesomeTimes               Icon = 0xF00D
+	FontAwesomeTimesCircle         Icon = 0xF057
+	FontAwesomeTrash               Icon = 0xF1F8
+	FontAwesomeTrashAlt            Icon = 0xF2ED
+	FontAwesomeUndo                Icon = 0xF0E2
+	FontAwesomeUpload              Icon = 0xF093
+	FontAwesomeUser                Icon = 0xF007
+	FontAwesomeVolumeUp            Icon = 0xF028
+	FontAwesomeWrench              Icon = 0xF0AD
+)
+
+// FontAwesome5 describes Font Awesome 5 in the solid style (fa-solid-900.ttf).
+// The icons are rendered at two thirds of the text size, which is the size the font is designed for.
+var FontAwesome5 = IconFont{
+	Name:            "Font Awesome 5",
+	First:           0xE005,
+	Last:            0xF8FF,
+	SizeScale:       2.0 / 3.0,
+	MinAdvanceScale: 1.0,
+	OffsetYScale:    0,
+}
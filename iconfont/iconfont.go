@@ -0,0 +1,96 @@
+// Package iconfont merges icon fonts, such as Font Awesome or Material Icons, into fonts of an imgui font atlas.
+//
+// The package does not contain any font data. Load the TTF file of the icon font yourself, for example with
+// go:embed, and pass its bytes to Merge() or AddDefaultFont(). Icons are then used within text by their codepoints:
+//   atlas := imgui.CurrentIO().Fonts()
+//   iconfont.AddDefaultFont(atlas, iconfont.FontAwesome5, fontAwesomeSolidTTF)
+//   ...
+//   imgui.Button(iconfont.FontAwesomeSave.String() + " Save")
+package iconfont
+
+import (
+	"sync"
+
+	"github.com/ianling/imgui-go"
+)
+
+// Icon is the codepoint of an icon within its icon font.
+type Icon rune
+
+// String returns the icon as text, to be used within labels.
+func (icon Icon) String() string {
+	return string(rune(icon))
+}
+
+// IconFont describes the properties of an icon font that are necessary to merge it with text fonts.
+// The size related properties are factors relative to the size of the text font the icons are merged into.
+type IconFont struct {
+	// Name is used as the name of the merged font configuration.
+	Name string
+	// First and Last are the range of codepoints used by the font.
+	First, Last Icon
+	// SizeScale is the size of the icons. Icon fonts are often designed larger than text at the same pixel size.
+	SizeScale float32
+	// MinAdvanceScale is the minimum advance of the icons, making icons of different width line up.
+	MinAdvanceScale float32
+	// OffsetYScale moves the icons downwards to align them with the baseline of the text.
+	OffsetYScale float32
+}
+
+// DefaultFontSize is the pixel size of the built-in default font of imgui.
+const DefaultFontSize = 13
+
+var glyphRanges = struct {
+	sync.Mutex
+	byRange map[[2]Icon]imgui.GlyphRanges
+}{byRange: make(map[[2]Icon]imgui.GlyphRanges)}
+
+// GlyphRanges returns the glyph ranges of the icon font.
+// The ranges are kept for the lifetime of the process, as font atlases refer to them.
+func (font IconFont) GlyphRanges() imgui.GlyphRanges {
+	glyphRanges.Lock()
+	defer glyphRanges.Unlock()
+	key := [2]Icon{font.First, font.Last}
+	if ranges, known := glyphRanges.byRange[key]; known {
+		return ranges
+	}
+	builder := imgui.NewFontGlyphRangesBuilder()
+	builder.AddRange(rune(font.First), rune(font.Last))
+	ranges := imgui.NewGlyphRanges()
+	builder.BuildRanges(ranges)
+	glyphRanges.byRange[key] = ranges.Data()
+	return ranges.Data()
+}
+
+// Merge adds the icon font, given as TTF data, to the atlas, merging it into the font that was added last.
+// textSizePixels is the size the text font was added with. Sizes are scaled by imgui.DPIScale, as with
+// AddFontFromMemoryTTF(); use a textSizePixels that is already scaled for fonts that were added otherwise.
+// It returns the font the icons were merged into.
+func Merge(atlas imgui.FontAtlas, font IconFont, data []byte, textSizePixels float32) imgui.Font {
+	scale := float32(1)
+	if imgui.DPIScale > 0 {
+		scale = imgui.DPIScale
+	}
+	iconSize := textSizePixels * font.SizeScale
+
+	config := imgui.NewFontConfig()
+	defer config.Delete()
+	config.SetName(font.Name)
+	config.SetMergeMode(true)
+	config.SetPixelSnapH(true)
+	config.SetGlyphMinAdvanceX(textSizePixels * font.MinAdvanceScale * scale)
+	config.SetGlyphOffsetY(textSizePixels * font.OffsetYScale * scale)
+	return atlas.AddFontFromMemoryTTFV(data, iconSize, config, font.GlyphRanges())
+}
+
+// AddDefaultFont adds the default font of imgui to the atlas, merged with the icon font given as TTF data.
+// The default font is scaled by imgui.DPIScale, the same as the icons.
+func AddDefaultFont(atlas imgui.FontAtlas, font IconFont, data []byte) imgui.Font {
+	config := imgui.NewFontConfig()
+	defer config.Delete()
+	if imgui.DPIScale > 0 {
+		config.SetSize(DefaultFontSize * imgui.DPIScale)
+	}
+	atlas.AddFontDefaultV(config)
+	return Merge(atlas, font, data, DefaultFontSize)
+}
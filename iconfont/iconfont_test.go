@@ -0,0 +1,21 @@
+package iconfont_test
+
+import (
+	"testing"
+
+	"github.com/ianling/imgui-go/iconfont"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIconString(t *testing.T) {
+	assert.Equal(t, "\uf0c7", iconfont.FontAwesomeSave.String())
+}
+
+func TestGlyphRangesAreShared(t *testing.T) {
+	first := iconfont.FontAwesome5.GlyphRanges()
+	second := iconfont.FontAwesome5.GlyphRanges()
+	assert.NotEqual(t, 0, first)
+	assert.Equal(t, first, second, "Ranges of the same font should be built once")
+	assert.NotEqual(t, first, iconfont.MaterialIcons.GlyphRanges())
+}
@@ -0,0 +1,59 @@
+package iconfont
+
+// Codepoints of commonly used icons of Material Icons (MaterialIcons-Regular.ttf).
+// Other icons of the font can be used with their codepoint as Icon(0xE...), within the range of MaterialIcons.
+const (
+	MaterialAdd           Icon = 0xE145
+	MaterialArrowBack     Icon = 0xE5C4
+	MaterialArrowForward  Icon = 0xE5C8
+	MaterialCheck         Icon = 0xE5CA
+	MaterialClose         Icon = 0xE5CD
+	MaterialContentCopy   Icon = 0xE14D
+	MaterialContentCut    Icon = 0xE14E
+	MaterialContentPaste  Icon = 0xE14F
+	MaterialDelete        Icon = 0xE872
+	MaterialDescription   Icon = 0xE873
+	MaterialEdit          Icon = 0xE3C9
+	MaterialError         Icon = 0xE000
+	MaterialExpandLess    Icon = 0xE5CE
+	MaterialExpandMore    Icon = 0xE5CF
+	MaterialFavorite      Icon = 0xE87D
+	MaterialFileDownload  Icon = 0xE2C4
+	MaterialFileUpload    Icon = 0xE2C6
+	MaterialFilterList    Icon = 0xE152
+	MaterialFolder        Icon = 0xE2C7
+	MaterialFolderOpen    Icon = 0xE2C8
+	MaterialHelp          Icon = 0xE887
+	MaterialHome          Icon = 0xE88A
+	MaterialInfo          Icon = 0xE88E
+	MaterialLock          Icon = 0xE897
+	MaterialLockOpen      Icon = 0xE898
+	MaterialMenu          Icon = 0xE5D2
+	MaterialNotifications Icon = 0xE7F4
+	MaterialPause         Icon = 0xE034
+	MaterialPerson        Icon = 0xE7FD
+	MaterialPlayArrow     Icon = 0xE037
+	MaterialRedo          Icon = 0xE15A
+	MaterialRefresh       Icon = 0xE5D5
+	MaterialRemove        Icon = 0xE15B
+	MaterialSave          Icon = 0xE161
+	MaterialSearch        Icon = 0xE8B6
+	MaterialSettings      Icon = 0xE8B8
+	MaterialStar          Icon = 0xE838
+	MaterialStop          Icon = 0xE047
+	MaterialUndo          Icon = 0xE166
+	MaterialVisibility    Icon = 0xE8F4
+	MaterialVisibilityOff Icon = 0xE8F5
+	MaterialWarning       Icon = 0xE002
+)
+
+// MaterialIcons describes the Material Icons font of Google (MaterialIcons-Regular.ttf).
+// The icons are rendered at text size and moved down, as they are designed to sit on top of the baseline.
+var MaterialIcons = IconFont{
+	Name:            "Material Icons",
+	First:           0xE000,
+	Last:            0xF8FF,
+	SizeScale:       1.0,
+	MinAdvanceScale: 1.0,
+	OffsetYScale:    0.2,
+}
@@ -20,6 +20,7 @@
 #include "wrapper/DrawList.cpp"
 #include "wrapper/Font.cpp"
 #include "wrapper/FontAtlas.cpp"
+#include "wrapper/FontAtlasCache.cpp"
 #include "wrapper/FontConfig.cpp"
 #include "wrapper/FontGlyphRangesBuilder.cpp"
 #include "wrapper/InputTextCallbackData.cpp"
@@ -32,6 +33,7 @@
 #include "wrapper/State.cpp"
 #include "wrapper/Style.cpp"
 #include "wrapper/Tables.cpp"
+#include "wrapper/TextFilter.cpp"
 #include "wrapper/Widgets.cpp"
 #include "wrapper/Window.cpp"
 #include "wrapper/WrapperConverter.cpp"
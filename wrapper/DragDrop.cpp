@@ -1,4 +1,5 @@
 #include "ConfiguredImGui.h"
+#include "imgui_internal.h"
 
 #include "DragDrop.h"
 
@@ -44,4 +45,15 @@ const IggPayload iggAcceptDragDropPayload(const char *type, int flags)
 void iggEndDragDropTarget()
 {
    ImGui::EndDragDropTarget();
-}
\ No newline at end of file
+}
+
+void iggKeepExternDragDropSourceActive()
+{
+   // The ID is the one BeginDragDropSource() uses for external sources.
+   ImGuiID id = ImHashStr("#SourceExtern");
+   if (ImGui::GetActiveID() != id)
+   {
+      ImGui::SetActiveID(id, NULL);
+   }
+   ImGui::KeepAliveID(id);
+}
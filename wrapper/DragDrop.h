@@ -13,6 +13,8 @@ extern IggBool iggBeginDragDropTarget();
 extern const IggPayload iggAcceptDragDropPayload(const char *type, int flags);
 extern void iggEndDragDropTarget();
 
+extern void iggKeepExternDragDropSourceActive();
+
 extern void *iggPayloadData(const IggPayload payload);
 extern int iggPayloadDataSize(const IggPayload payload);
 
@@ -1,6 +1,7 @@
 #include "ConfiguredImGui.h"
 
 #include "Font.h"
+#include "WrapperConverter.h"
 
 void iggPushFont(IggFont handle)
 {
@@ -22,3 +23,105 @@ void iggCalcTextSize(const char *text, int length, IggBool hide_text_after_doubl
 {
    exportValue(*value, ImGui::CalcTextSize(text, text + length, hide_text_after_double_hash, wrap_width));
 }
+
+IggFont iggGetFont()
+{
+   return static_cast<IggFont>(ImGui::GetFont());
+}
+
+static ImFont *iggFontOrDefault(IggFont handle)
+{
+   ImFont *font = reinterpret_cast<ImFont *>(handle);
+   return (font != nullptr) ? font : ImGui::GetDefaultFont();
+}
+
+float iggFontFontSize(IggFont handle)
+{
+   return iggFontOrDefault(handle)->FontSize;
+}
+
+float iggFontAscent(IggFont handle)
+{
+   return iggFontOrDefault(handle)->Ascent;
+}
+
+float iggFontDescent(IggFont handle)
+{
+   return iggFontOrDefault(handle)->Descent;
+}
+
+unsigned int iggFontFallbackChar(IggFont handle)
+{
+   return iggFontOrDefault(handle)->FallbackChar;
+}
+
+int iggFontEllipsisChar(IggFont handle)
+{
+   ImWchar c = iggFontOrDefault(handle)->EllipsisChar;
+   return (c == static_cast<ImWchar>(-1)) ? -1 : static_cast<int>(c);
+}
+
+float iggFontCharAdvance(IggFont handle, unsigned int c)
+{
+   ImFont *font = iggFontOrDefault(handle);
+   if (c > IM_UNICODE_CODEPOINT_MAX)
+   {
+      return font->FallbackAdvanceX;
+   }
+   return font->GetCharAdvance(static_cast<ImWchar>(c));
+}
+
+static void iggExportFontGlyph(IggFontGlyph *glyph, ImFontGlyph const *found)
+{
+   glyph->colored = found->Colored ? 1 : 0;
+   glyph->visible = found->Visible ? 1 : 0;
+   glyph->codepoint = found->Codepoint;
+   glyph->advanceX = found->AdvanceX;
+   exportValue(glyph->min, ImVec2(found->X0, found->Y0));
+   exportValue(glyph->max, ImVec2(found->X1, found->Y1));
+   exportValue(glyph->uv0, ImVec2(found->U0, found->V0));
+   exportValue(glyph->uv1, ImVec2(found->U1, found->V1));
+}
+
+IggBool iggFontFindGlyph(IggFont handle, unsigned int c, IggBool fallback, IggFontGlyph *glyph)
+{
+   ImFont *font = iggFontOrDefault(handle);
+   ImFontGlyph const *found = nullptr;
+   if (c <= IM_UNICODE_CODEPOINT_MAX)
+   {
+      found = (fallback != 0) ? font->FindGlyph(static_cast<ImWchar>(c)) : font->FindGlyphNoFallback(static_cast<ImWchar>(c));
+   }
+   else if (fallback != 0)
+   {
+      found = font->FallbackGlyph;
+   }
+   if (found == nullptr)
+   {
+      return 0;
+   }
+   iggExportFontGlyph(glyph, found);
+   return 1;
+}
+
+int iggFontGlyphCount(IggFont handle)
+{
+   return iggFontOrDefault(handle)->Glyphs.Size;
+}
+
+void iggFontGlyphByIndex(IggFont handle, int index, IggFontGlyph *glyph)
+{
+   iggExportFontGlyph(glyph, &iggFontOrDefault(handle)->Glyphs[index]);
+}
+
+void iggFontCalcTextSizeA(IggFont handle, float size, float maxWidth, float wrapWidth, const char *text, int length, IggVec2 *value, int *remaining)
+{
+   char const *remainingText = nullptr;
+   exportValue(*value, iggFontOrDefault(handle)->CalcTextSizeA(size, maxWidth, wrapWidth, text, text + length, &remainingText));
+   *remaining = static_cast<int>(remainingText - text);
+}
+
+int iggFontCalcWordWrapPositionA(IggFont handle, float scale, const char *text, int length, float wrapWidth)
+{
+   char const *position = iggFontOrDefault(handle)->CalcWordWrapPositionA(scale, text, text + length, wrapWidth);
+   return static_cast<int>(position - text);
+}
@@ -6,10 +6,35 @@
 extern "C" {
 #endif
 
+typedef struct tagIggFontGlyph
+{
+   IggBool colored;
+   IggBool visible;
+   unsigned int codepoint;
+   float advanceX;
+   IggVec2 min;
+   IggVec2 max;
+   IggVec2 uv0;
+   IggVec2 uv1;
+} IggFontGlyph;
+
 extern void iggPushFont(IggFont handle);
 extern void iggPopFont(void);
 extern void iggCalcTextSize(const char *text, int length, IggBool hide_text_after_double_hash, float wrap_width, IggVec2 *value);
 extern float iggGetFontSize();
+extern IggFont iggGetFont();
+
+extern float iggFontFontSize(IggFont handle);
+extern float iggFontAscent(IggFont handle);
+extern float iggFontDescent(IggFont handle);
+extern unsigned int iggFontFallbackChar(IggFont handle);
+extern int iggFontEllipsisChar(IggFont handle);
+extern float iggFontCharAdvance(IggFont handle, unsigned int c);
+extern IggBool iggFontFindGlyph(IggFont handle, unsigned int c, IggBool fallback, IggFontGlyph *glyph);
+extern int iggFontGlyphCount(IggFont handle);
+extern void iggFontGlyphByIndex(IggFont handle, int index, IggFontGlyph *glyph);
+extern void iggFontCalcTextSizeA(IggFont handle, float size, float maxWidth, float wrapWidth, const char *text, int length, IggVec2 *value, int *remaining);
+extern int iggFontCalcWordWrapPositionA(IggFont handle, float scale, const char *text, int length, float wrapWidth);
 
 #ifdef __cplusplus
 }
@@ -7,7 +7,7 @@
 IggGlyphRanges iggGetGlyphRangesAll()
 {
    static const ImWchar ranges[] = {
-      0x0001, 0xFFFF,
+      0x0001, IM_UNICODE_CODEPOINT_MAX,
       0,
    };
    return static_cast<IggGlyphRanges>(const_cast<ImWchar *>(&ranges[0]));
@@ -140,3 +140,129 @@ void iggFontAtlasSetFontBuilderFlags(IggFontAtlas handle, unsigned int flags)
    fontAtlas->FontBuilderFlags = flags;
 }
 
+
+IggBool iggFontAtlasIsBuilt(IggFontAtlas handle)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   return fontAtlas->IsBuilt() ? 1 : 0;
+}
+
+void iggFontAtlasClearTexData(IggFontAtlas handle)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   fontAtlas->ClearTexData();
+}
+
+IggBool iggFontAtlasTexPixelsUseColors(IggFontAtlas handle)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   return fontAtlas->TexPixelsUseColors ? 1 : 0;
+}
+
+int iggFontAtlasGetFlags(IggFontAtlas handle)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   return fontAtlas->Flags;
+}
+
+void iggFontAtlasSetFlags(IggFontAtlas handle, int flags)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   fontAtlas->Flags = flags;
+}
+
+int iggFontAtlasGetTexGlyphPadding(IggFontAtlas handle)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   return fontAtlas->TexGlyphPadding;
+}
+
+void iggFontAtlasSetTexGlyphPadding(IggFontAtlas handle, int padding)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   fontAtlas->TexGlyphPadding = padding;
+}
+
+int iggFontAtlasFontCount(IggFontAtlas handle)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   return fontAtlas->Fonts.Size;
+}
+
+IggFont iggFontAtlasFontByIndex(IggFontAtlas handle, int index)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   return static_cast<IggFont>(fontAtlas->Fonts[index]);
+}
+
+int iggFontAtlasConfigCount(IggFontAtlas handle)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   return fontAtlas->ConfigData.Size;
+}
+
+void iggFontAtlasSetConfigGlyphRanges(IggFontAtlas handle, int index, IggGlyphRanges glyphRanges)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   fontAtlas->ConfigData[index].GlyphRanges = reinterpret_cast<ImWchar const *>(glyphRanges);
+}
+
+int iggFontAtlasAddCustomRectRegular(IggFontAtlas handle, int width, int height)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   return fontAtlas->AddCustomRectRegular(width, height);
+}
+
+IggFont iggFontAtlasFontOrDefault(IggFontAtlas handle, IggFont font)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   if (font != nullptr)
+   {
+      return font;
+   }
+   ImFont *defaultFont = (ImGui::GetCurrentContext() != nullptr) ? ImGui::GetIO().FontDefault : nullptr;
+   if ((defaultFont != nullptr) && (defaultFont->ContainerAtlas == fontAtlas))
+   {
+      return static_cast<IggFont>(defaultFont);
+   }
+   return fontAtlas->Fonts.empty() ? nullptr : static_cast<IggFont>(fontAtlas->Fonts[0]);
+}
+
+int iggFontAtlasAddCustomRectFontGlyph(IggFontAtlas handle, IggFont font, unsigned int id, int width, int height,
+   float advanceX, IggVec2 const *offset)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   Vec2Wrapper offsetArg(offset);
+   return fontAtlas->AddCustomRectFontGlyph(reinterpret_cast<ImFont *>(font), static_cast<ImWchar>(id), width, height,
+      advanceX, *offsetArg);
+}
+
+int iggFontAtlasCustomRectCount(IggFontAtlas handle)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   return fontAtlas->CustomRects.Size;
+}
+
+void iggFontAtlasGetCustomRectByIndex(IggFontAtlas handle, int index, IggFontAtlasCustomRect *rect)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   ImFontAtlasCustomRect const *customRect = fontAtlas->GetCustomRectByIndex(index);
+   rect->width = customRect->Width;
+   rect->height = customRect->Height;
+   rect->x = customRect->IsPacked() ? customRect->X : -1;
+   rect->y = customRect->IsPacked() ? customRect->Y : -1;
+   rect->glyphID = customRect->GlyphID;
+   rect->glyphAdvanceX = customRect->GlyphAdvanceX;
+   exportValue(rect->glyphOffset, customRect->GlyphOffset);
+   rect->font = static_cast<IggFont>(customRect->Font);
+}
+
+void iggFontAtlasCalcCustomRectUV(IggFontAtlas handle, int index, IggVec2 *uvMin, IggVec2 *uvMax)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   ImVec2 min;
+   ImVec2 max;
+   fontAtlas->CalcCustomRectUV(fontAtlas->GetCustomRectByIndex(index), &min, &max);
+   exportValue(*uvMin, min);
+   exportValue(*uvMax, max);
+}
@@ -6,6 +6,18 @@
 extern "C" {
 #endif
 
+typedef struct tagIggFontAtlasCustomRect
+{
+   int width;
+   int height;
+   int x;
+   int y;
+   unsigned int glyphID;
+   float glyphAdvanceX;
+   IggVec2 glyphOffset;
+   IggFont font;
+} IggFontAtlasCustomRect;
+
 extern IggGlyphRanges iggGetGlyphRangesAll();
 extern IggGlyphRanges iggGetGlyphRangesDefault(IggFontAtlas handle);
 extern IggGlyphRanges iggGetGlyphRangesKorean(IggFontAtlas handle);
@@ -35,6 +47,26 @@ extern void iggFontAtlasClear(IggFontAtlas handle);
 extern unsigned int iggFontAtlasGetFontBuilderFlags(IggFontAtlas handle);
 extern void         iggFontAtlasSetFontBuilderFlags(IggFontAtlas handle, unsigned int flags);
 
+extern IggBool iggFontAtlasIsBuilt(IggFontAtlas handle);
+extern void iggFontAtlasClearTexData(IggFontAtlas handle);
+extern IggBool iggFontAtlasTexPixelsUseColors(IggFontAtlas handle);
+extern int iggFontAtlasGetFlags(IggFontAtlas handle);
+extern void iggFontAtlasSetFlags(IggFontAtlas handle, int flags);
+extern int iggFontAtlasGetTexGlyphPadding(IggFontAtlas handle);
+extern void iggFontAtlasSetTexGlyphPadding(IggFontAtlas handle, int padding);
+extern int iggFontAtlasFontCount(IggFontAtlas handle);
+extern IggFont iggFontAtlasFontByIndex(IggFontAtlas handle, int index);
+extern int iggFontAtlasConfigCount(IggFontAtlas handle);
+extern void iggFontAtlasSetConfigGlyphRanges(IggFontAtlas handle, int index, IggGlyphRanges glyphRanges);
+
+extern int iggFontAtlasAddCustomRectRegular(IggFontAtlas handle, int width, int height);
+extern IggFont iggFontAtlasFontOrDefault(IggFontAtlas handle, IggFont font);
+extern int iggFontAtlasAddCustomRectFontGlyph(IggFontAtlas handle, IggFont font, unsigned int id, int width, int height,
+   float advanceX, IggVec2 const *offset);
+extern int iggFontAtlasCustomRectCount(IggFontAtlas handle);
+extern void iggFontAtlasGetCustomRectByIndex(IggFontAtlas handle, int index, IggFontAtlasCustomRect *rect);
+extern void iggFontAtlasCalcCustomRectUV(IggFontAtlas handle, int index, IggVec2 *uvMin, IggVec2 *uvMax);
+
 #ifdef __cplusplus
 }
 #endif
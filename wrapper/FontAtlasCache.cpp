@@ -0,0 +1,181 @@
+#include "ConfiguredImGui.h"
+
+#include "FontAtlasCache.h"
+#include "WrapperConverter.h"
+
+void iggFontAtlasGetInputs(IggFontAtlas handle, IggFontAtlasInputs *inputs)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   inputs->version = IMGUI_VERSION_NUM;
+   inputs->wcharSize = static_cast<int>(sizeof(ImWchar));
+#ifdef IMGUI_ENABLE_FREETYPE
+   inputs->freeType = 1;
+#else
+   inputs->freeType = 0;
+#endif
+   inputs->flags = fontAtlas->Flags;
+   inputs->texDesiredWidth = fontAtlas->TexDesiredWidth;
+   inputs->texGlyphPadding = fontAtlas->TexGlyphPadding;
+   inputs->fontBuilderFlags = fontAtlas->FontBuilderFlags;
+}
+
+void iggFontAtlasGetConfigInputs(IggFontAtlas handle, int index, IggFontConfigInputs *inputs)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   ImFontConfig const &config = fontAtlas->ConfigData[index];
+   inputs->fontData = config.FontData;
+   inputs->fontDataSize = config.FontDataSize;
+   inputs->fontNo = config.FontNo;
+   inputs->sizePixels = config.SizePixels;
+   inputs->oversampleH = config.OversampleH;
+   inputs->oversampleV = config.OversampleV;
+   inputs->pixelSnapH = config.PixelSnapH ? 1 : 0;
+   inputs->mergeMode = config.MergeMode ? 1 : 0;
+   exportValue(inputs->glyphExtraSpacing, config.GlyphExtraSpacing);
+   exportValue(inputs->glyphOffset, config.GlyphOffset);
+   ImWchar const *ranges = (config.GlyphRanges != nullptr) ? config.GlyphRanges : fontAtlas->GetGlyphRangesDefault();
+   int count = 0;
+   while (ranges[count] != 0)
+   {
+      count++;
+   }
+   inputs->glyphRanges = ranges;
+   inputs->glyphRangesSize = count * static_cast<int>(sizeof(ImWchar));
+   inputs->glyphMinAdvanceX = config.GlyphMinAdvanceX;
+   inputs->glyphMaxAdvanceX = config.GlyphMaxAdvanceX;
+   inputs->rasterizerMultiply = config.RasterizerMultiply;
+   inputs->fontBuilderFlags = config.FontBuilderFlags;
+   inputs->ellipsisChar = (config.EllipsisChar == static_cast<ImWchar>(-1)) ? -1 : static_cast<int>(config.EllipsisChar);
+   inputs->fontIndex = -1;
+   for (int i = 0; i < fontAtlas->Fonts.Size; i++)
+   {
+      if (fontAtlas->Fonts[i] == config.DstFont)
+      {
+         inputs->fontIndex = i;
+      }
+   }
+}
+
+int iggFontAtlasTexUvLinesCount()
+{
+   return IM_DRAWLIST_TEX_LINES_WIDTH_MAX + 1;
+}
+
+void iggFontAtlasGetLayout(IggFontAtlas handle, IggFontAtlasLayout *layout)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   layout->texWidth = fontAtlas->TexWidth;
+   layout->texHeight = fontAtlas->TexHeight;
+   layout->useColors = fontAtlas->TexPixelsUseColors ? 1 : 0;
+   exportValue(layout->uvWhitePixel, fontAtlas->TexUvWhitePixel);
+   if (fontAtlas->TexPixelsAlpha8 != nullptr)
+   {
+      layout->bytesPerPixel = 1;
+      layout->pixels = fontAtlas->TexPixelsAlpha8;
+   }
+   else
+   {
+      layout->bytesPerPixel = 4;
+      layout->pixels = fontAtlas->TexPixelsRGBA32;
+   }
+}
+
+void iggFontAtlasGetTexUvLines(IggFontAtlas handle, IggVec4 *lines)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   for (int i = 0; i < iggFontAtlasTexUvLinesCount(); i++)
+   {
+      exportValue(lines[i], fontAtlas->TexUvLines[i]);
+   }
+}
+
+void *iggFontAtlasBeginRestore(IggFontAtlas handle, IggFontAtlasLayout const *layout)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   fontAtlas->ClearTexData();
+   ImFontAtlasBuildInit(fontAtlas);
+   fontAtlas->TexWidth = layout->texWidth;
+   fontAtlas->TexHeight = layout->texHeight;
+   fontAtlas->TexUvScale = ImVec2(1.0f / static_cast<float>(layout->texWidth), 1.0f / static_cast<float>(layout->texHeight));
+   importValue(fontAtlas->TexUvWhitePixel, layout->uvWhitePixel);
+   fontAtlas->TexPixelsUseColors = layout->useColors != 0;
+   size_t pixelCount = static_cast<size_t>(layout->texWidth) * static_cast<size_t>(layout->texHeight);
+   if (layout->bytesPerPixel == 1)
+   {
+      fontAtlas->TexPixelsAlpha8 = static_cast<unsigned char *>(IM_ALLOC(pixelCount));
+      return fontAtlas->TexPixelsAlpha8;
+   }
+   fontAtlas->TexPixelsRGBA32 = static_cast<unsigned int *>(IM_ALLOC(pixelCount * 4));
+   return fontAtlas->TexPixelsRGBA32;
+}
+
+void iggFontAtlasRestoreTexUvLines(IggFontAtlas handle, IggVec4 const *lines)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   for (int i = 0; i < iggFontAtlasTexUvLinesCount(); i++)
+   {
+      importValue(fontAtlas->TexUvLines[i], lines[i]);
+   }
+}
+
+void iggFontAtlasRestoreCustomRect(IggFontAtlas handle, int index, int x, int y)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   ImFontAtlasCustomRect &rect = fontAtlas->CustomRects[index];
+   rect.X = static_cast<unsigned short>(x);
+   rect.Y = static_cast<unsigned short>(y);
+}
+
+void iggFontRestoreMetrics(IggFontAtlas handle, IggFont font, IggFontMetrics const *metrics)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   ImFont *imFont = reinterpret_cast<ImFont *>(font);
+   imFont->ClearOutputData();
+   imFont->ContainerAtlas = fontAtlas;
+   imFont->FontSize = metrics->fontSize;
+   imFont->Ascent = metrics->ascent;
+   imFont->Descent = metrics->descent;
+   imFont->FallbackChar = static_cast<ImWchar>(metrics->fallbackChar);
+   imFont->EllipsisChar = static_cast<ImWchar>(metrics->ellipsisChar);
+   imFont->ConfigData = nullptr;
+   imFont->ConfigDataCount = 0;
+   for (int i = 0; i < fontAtlas->ConfigData.Size; i++)
+   {
+      ImFontConfig *config = &fontAtlas->ConfigData[i];
+      if (config->DstFont != imFont)
+      {
+         continue;
+      }
+      if (imFont->ConfigData == nullptr)
+      {
+         imFont->ConfigData = config;
+      }
+      imFont->ConfigDataCount++;
+   }
+}
+
+void iggFontRestoreGlyph(IggFont font, IggFontGlyph const *glyph)
+{
+   ImFont *imFont = reinterpret_cast<ImFont *>(font);
+   imFont->AddGlyph(nullptr, static_cast<ImWchar>(glyph->codepoint),
+      glyph->min.x, glyph->min.y, glyph->max.x, glyph->max.y,
+      glyph->uv0.x, glyph->uv0.y, glyph->uv1.x, glyph->uv1.y, glyph->advanceX);
+   ImFontGlyph &added = imFont->Glyphs.back();
+   added.Visible = glyph->visible != 0;
+   added.Colored = glyph->colored != 0;
+}
+
+void iggFontAtlasEndRestore(IggFontAtlas handle)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   for (int i = 0; i < fontAtlas->Fonts.Size; i++)
+   {
+      fontAtlas->Fonts[i]->BuildLookupTable();
+   }
+}
+
+void iggFontAtlasBuildInit(IggFontAtlas handle)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   ImFontAtlasBuildInit(fontAtlas);
+}
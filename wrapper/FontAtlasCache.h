@@ -0,0 +1,79 @@
+#pragma once
+
+#include "Types.h"
+#include "Font.h"
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+typedef struct tagIggFontAtlasInputs
+{
+   int version;
+   int wcharSize;
+   IggBool freeType;
+   int flags;
+   int texDesiredWidth;
+   int texGlyphPadding;
+   unsigned int fontBuilderFlags;
+} IggFontAtlasInputs;
+
+typedef struct tagIggFontConfigInputs
+{
+   void const *fontData;
+   int fontDataSize;
+   int fontNo;
+   float sizePixels;
+   int oversampleH;
+   int oversampleV;
+   IggBool pixelSnapH;
+   IggBool mergeMode;
+   IggVec2 glyphExtraSpacing;
+   IggVec2 glyphOffset;
+   void const *glyphRanges;
+   int glyphRangesSize;
+   float glyphMinAdvanceX;
+   float glyphMaxAdvanceX;
+   float rasterizerMultiply;
+   unsigned int fontBuilderFlags;
+   int ellipsisChar;
+   int fontIndex;
+} IggFontConfigInputs;
+
+typedef struct tagIggFontAtlasLayout
+{
+   int texWidth;
+   int texHeight;
+   int bytesPerPixel;
+   IggBool useColors;
+   IggVec2 uvWhitePixel;
+   void *pixels;
+} IggFontAtlasLayout;
+
+typedef struct tagIggFontMetrics
+{
+   float fontSize;
+   float ascent;
+   float descent;
+   unsigned int fallbackChar;
+   int ellipsisChar;
+} IggFontMetrics;
+
+extern void iggFontAtlasBuildInit(IggFontAtlas handle);
+extern void iggFontAtlasGetInputs(IggFontAtlas handle, IggFontAtlasInputs *inputs);
+extern void iggFontAtlasGetConfigInputs(IggFontAtlas handle, int index, IggFontConfigInputs *inputs);
+
+extern int iggFontAtlasTexUvLinesCount();
+extern void iggFontAtlasGetLayout(IggFontAtlas handle, IggFontAtlasLayout *layout);
+extern void iggFontAtlasGetTexUvLines(IggFontAtlas handle, IggVec4 *lines);
+
+extern void *iggFontAtlasBeginRestore(IggFontAtlas handle, IggFontAtlasLayout const *layout);
+extern void iggFontAtlasRestoreTexUvLines(IggFontAtlas handle, IggVec4 const *lines);
+extern void iggFontAtlasRestoreCustomRect(IggFontAtlas handle, int index, int x, int y);
+extern void iggFontRestoreMetrics(IggFontAtlas handle, IggFont font, IggFontMetrics const *metrics);
+extern void iggFontRestoreGlyph(IggFont font, IggFontGlyph const *glyph);
+extern void iggFontAtlasEndRestore(IggFontAtlas handle);
+
+#ifdef __cplusplus
+}
+#endif
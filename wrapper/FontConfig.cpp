@@ -38,6 +38,12 @@ void iggFontConfigSetPixelSnapH(IggFontConfig handle, IggBool value)
    fontConfig->PixelSnapH = value;
 }
 
+void iggFontConfigSetFontNo(IggFontConfig handle, int value)
+{
+   ImFontConfig *fontConfig = reinterpret_cast<ImFontConfig *>(handle);
+   fontConfig->FontNo = value;
+}
+
 void iggFontConfigSetGlyphMinAdvanceX(IggFontConfig handle, float value)
 {
    ImFontConfig *fontConfig = reinterpret_cast<ImFontConfig *>(handle);
@@ -68,6 +74,12 @@ void iggFontConfigSetMergeMode(IggFontConfig handle, IggBool value)
    fontConfig->MergeMode = value;
 }
 
+void iggFontConfigSetGlyphRanges(IggFontConfig handle, IggGlyphRanges glyphRanges)
+{
+   ImFontConfig *fontConfig = reinterpret_cast<ImFontConfig *>(handle);
+   fontConfig->GlyphRanges = reinterpret_cast<ImWchar const *>(glyphRanges);
+}
+
 void iggFontConfigSetName(IggFontConfig handle, char const *value)
 {
    ImFontConfig *fontConfig = reinterpret_cast<ImFontConfig *>(handle);
@@ -13,11 +13,13 @@ extern void iggFontConfigSetSize(IggFontConfig handle, float sizePixels);
 extern void iggFontConfigSetOversampleH(IggFontConfig handle, int value);
 extern void iggFontConfigSetOversampleV(IggFontConfig handle, int value);
 extern void iggFontConfigSetPixelSnapH(IggFontConfig handle, IggBool value);
+extern void iggFontConfigSetFontNo(IggFontConfig handle, int value);
 extern void iggFontConfigSetGlyphMinAdvanceX(IggFontConfig handle, float value);
 extern void iggFontConfigSetGlyphMaxAdvanceX(IggFontConfig handle, float value);
 extern void iggFontConfigSetGlyphOffsetX(IggFontConfig handle, float value);
 extern void iggFontConfigSetGlyphOffsetY(IggFontConfig handle, float value);
 extern void iggFontConfigSetMergeMode(IggFontConfig handle, IggBool value);
+extern void iggFontConfigSetGlyphRanges(IggFontConfig handle, IggGlyphRanges glyphRanges);
 extern void iggFontConfigSetName(IggFontConfig handle, char const *value);
 extern int iggFontConfigGetFontDataOwnedByAtlas(IggFontConfig handle);
 
@@ -12,6 +12,11 @@ IggGlyphRanges IggGlyphRangesData(IggGlyphRanges handle) {
   return static_cast<IggGlyphRanges>(ranges->Data);
 }
 
+void IggDeleteGlyphRanges(IggGlyphRanges handle) {
+  ImVector<ImWchar> *ranges = reinterpret_cast<ImVector<ImWchar>*>(handle);
+  delete ranges;
+}
+
 IggFontGlyphRangesBuilder IggNewFontGlyphRangesBuilder()
 {
   ImFontGlyphRangesBuilder *builder = new ImFontGlyphRangesBuilder();
@@ -24,6 +29,34 @@ void IggFontGlyphRangesBuilderClear(IggFontGlyphRangesBuilder handle)
   builder->Clear();
 }
 
+IggBool IggFontGlyphRangesBuilderHasChar(IggFontGlyphRangesBuilder handle, unsigned int c)
+{
+  ImFontGlyphRangesBuilder *builder = reinterpret_cast<ImFontGlyphRangesBuilder*>(handle);
+  return ((c <= IM_UNICODE_CODEPOINT_MAX) && builder->GetBit(c)) ? 1 : 0;
+}
+
+void IggFontGlyphRangesBuilderAddChar(IggFontGlyphRangesBuilder handle, unsigned int c)
+{
+  ImFontGlyphRangesBuilder *builder = reinterpret_cast<ImFontGlyphRangesBuilder*>(handle);
+  if (c <= IM_UNICODE_CODEPOINT_MAX)
+  {
+    builder->AddChar(static_cast<ImWchar>(c));
+  }
+}
+
+void IggFontGlyphRangesBuilderAddRange(IggFontGlyphRangesBuilder handle, unsigned int first, unsigned int last)
+{
+  ImFontGlyphRangesBuilder *builder = reinterpret_cast<ImFontGlyphRangesBuilder*>(handle);
+  if (last > IM_UNICODE_CODEPOINT_MAX)
+  {
+    last = IM_UNICODE_CODEPOINT_MAX;
+  }
+  for (unsigned int c = first; c <= last; c++)
+  {
+    builder->AddChar(static_cast<ImWchar>(c));
+  }
+}
+
 void IggFontGlyphRangesBuilderAddText(IggFontGlyphRangesBuilder handle, const char* text)
 {
   ImFontGlyphRangesBuilder *builder = reinterpret_cast<ImFontGlyphRangesBuilder*>(handle);
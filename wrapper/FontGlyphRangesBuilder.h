@@ -9,10 +9,14 @@ extern "C"
 
 extern IggGlyphRanges IggNewGlyphRanges();
 extern IggGlyphRanges IggGlyphRangesData(IggGlyphRanges handle);
+extern void IggDeleteGlyphRanges(IggGlyphRanges handle);
 
 extern IggFontGlyphRangesBuilder IggNewFontGlyphRangesBuilder();
 extern void IggFontGlyphRangesBuilderClear(IggFontGlyphRangesBuilder handle);
 extern void IggFontGlyphRangesBuilderAddRanges(IggFontGlyphRangesBuilder handle, IggGlyphRanges ranges);
+extern IggBool IggFontGlyphRangesBuilderHasChar(IggFontGlyphRangesBuilder handle, unsigned int c);
+extern void IggFontGlyphRangesBuilderAddChar(IggFontGlyphRangesBuilder handle, unsigned int c);
+extern void IggFontGlyphRangesBuilderAddRange(IggFontGlyphRangesBuilder handle, unsigned int first, unsigned int last);
 extern void IggFontGlyphRangesBuilderAddText(IggFontGlyphRangesBuilder handle, const char* text);
 extern void IggFontGlyphRangesBuilderBuildRanges(IggFontGlyphRangesBuilder handle, IggGlyphRanges ranges);
 
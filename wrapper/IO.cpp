@@ -186,6 +186,30 @@ void iggIoKeySuper(IggIO handle, int leftSuper, int rightSuper)
    io.KeySuper = io.KeysDown[leftSuper] || io.KeysDown[rightSuper];
 }
 
+IggBool iggIoKeyCtrlPressed(IggIO handle)
+{
+   ImGuiIO &io = *reinterpret_cast<ImGuiIO *>(handle);
+   return io.KeyCtrl ? 1 : 0;
+}
+
+IggBool iggIoKeyShiftPressed(IggIO handle)
+{
+   ImGuiIO &io = *reinterpret_cast<ImGuiIO *>(handle);
+   return io.KeyShift ? 1 : 0;
+}
+
+IggBool iggIoKeyAltPressed(IggIO handle)
+{
+   ImGuiIO &io = *reinterpret_cast<ImGuiIO *>(handle);
+   return io.KeyAlt ? 1 : 0;
+}
+
+IggBool iggIoKeySuperPressed(IggIO handle)
+{
+   ImGuiIO &io = *reinterpret_cast<ImGuiIO *>(handle);
+   return io.KeySuper ? 1 : 0;
+}
+
 void iggIoAddInputCharactersUTF8(IggIO handle, char const *utf8Chars)
 {
    ImGuiIO &io = *reinterpret_cast<ImGuiIO *>(handle);
@@ -254,6 +278,17 @@ void iggIoClearClipboardFunctions(IggIO handle)
    io.ClipboardUserData = nullptr;
 }
 
+void iggSetClipboardText(char const *text)
+{
+   ImGui::SetClipboardText(text);
+}
+
+char const *iggGetClipboardText()
+{
+   char const *text = ImGui::GetClipboardText();
+   return (text != nullptr) ? text : "";
+}
+
 int iggGetFrameCountSinceLastInput(IggIO handle)
 {
    ImGuiIO &io = *reinterpret_cast<ImGuiIO *>(handle);
@@ -40,6 +40,10 @@ extern void iggIoKeyCtrl(IggIO handle, int leftCtrl, int rightCtrl);
 extern void iggIoKeyShift(IggIO handle, int leftShift, int rightShift);
 extern void iggIoKeyAlt(IggIO handle, int leftAlt, int rightAlt);
 extern void iggIoKeySuper(IggIO handle, int leftSuper, int rightSuper);
+extern IggBool iggIoKeyCtrlPressed(IggIO handle);
+extern IggBool iggIoKeyShiftPressed(IggIO handle);
+extern IggBool iggIoKeyAltPressed(IggIO handle);
+extern IggBool iggIoKeySuperPressed(IggIO handle);
 extern void iggIoAddInputCharactersUTF8(IggIO handle, char const *utf8Chars);
 extern void iggIoSetIniFilename(IggIO handle, char const *value);
 extern void iggIoSetConfigFlags(IggIO handle, int flags);
@@ -50,6 +54,8 @@ extern void iggIoSetMouseDrawCursor(IggIO handle, IggBool show);
 
 extern void iggIoRegisterClipboardFunctions(IggIO handle);
 extern void iggIoClearClipboardFunctions(IggIO handle);
+extern void iggSetClipboardText(char const *text);
+extern char const *iggGetClipboardText(void);
 
 extern int iggGetFrameCountSinceLastInput(IggIO handle);
 extern void iggSetFrameCountSinceLastInput(IggIO handle, int count);
@@ -14,16 +14,16 @@ int iggInputTextCallbackDataGetFlags(IggInputTextCallbackData handle)
    return data->Flags;
 }
 
-unsigned short iggInputTextCallbackDataGetEventChar(IggInputTextCallbackData handle)
+unsigned int iggInputTextCallbackDataGetEventChar(IggInputTextCallbackData handle)
 {
    ImGuiInputTextCallbackData *data = reinterpret_cast<ImGuiInputTextCallbackData *>(handle);
    return data->EventChar;
 }
 
-void iggInputTextCallbackDataSetEventChar(IggInputTextCallbackData handle, unsigned short value)
+void iggInputTextCallbackDataSetEventChar(IggInputTextCallbackData handle, unsigned int value)
 {
    ImGuiInputTextCallbackData *data = reinterpret_cast<ImGuiInputTextCallbackData *>(handle);
-   data->EventChar = value;
+   data->EventChar = static_cast<ImWchar>(value);
 }
 
 int iggInputTextCallbackDataGetEventKey(IggInputTextCallbackData handle)
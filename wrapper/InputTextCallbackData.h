@@ -9,8 +9,8 @@ extern "C" {
 extern int iggInputTextCallbackDataGetEventFlag(IggInputTextCallbackData handle);
 extern int iggInputTextCallbackDataGetFlags(IggInputTextCallbackData handle);
 
-extern unsigned short iggInputTextCallbackDataGetEventChar(IggInputTextCallbackData handle);
-extern void iggInputTextCallbackDataSetEventChar(IggInputTextCallbackData handle, unsigned short value);
+extern unsigned int iggInputTextCallbackDataGetEventChar(IggInputTextCallbackData handle);
+extern void iggInputTextCallbackDataSetEventChar(IggInputTextCallbackData handle, unsigned int value);
 extern int iggInputTextCallbackDataGetEventKey(IggInputTextCallbackData handle);
 
 extern char *iggInputTextCallbackDataGetBuf(IggInputTextCallbackData handle);
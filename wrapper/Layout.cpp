@@ -18,6 +18,11 @@ void iggPopID(void)
    ImGui::PopID();
 }
 
+unsigned int iggGetID(char const *id)
+{
+   return ImGui::GetID(id);
+}
+
 void iggSeparator(void)
 {
    ImGui::Separator();
@@ -28,6 +33,11 @@ void iggSameLine(float posX, float spacingW)
    ImGui::SameLine(posX, spacingW);
 }
 
+void iggNewLine(void)
+{
+   ImGui::NewLine();
+}
+
 void iggSpacing(void)
 {
    ImGui::Spacing();
@@ -9,9 +9,11 @@ extern "C" {
 extern void iggPushID(char const *id);
 extern void iggPushIDInt(int id);
 extern void iggPopID(void);
+extern unsigned int iggGetID(char const *id);
 
 extern void iggSeparator(void);
 extern void iggSameLine(float posX, float spacingW);
+extern void iggNewLine(void);
 extern void iggSpacing(void);
 extern void iggDummy(IggVec2 const *size);
 extern void iggBeginGroup(void);
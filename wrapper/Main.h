@@ -8,6 +8,7 @@ extern "C" {
 
 extern char const *iggGetVersion(void);
 extern double iggGetTime(void);
+extern int iggGetFrameCount(void);
 
 extern void iggNewFrame(void);
 extern void iggRender(void);
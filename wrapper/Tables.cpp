@@ -82,6 +82,11 @@ void iggTableSetBgColor(int target, IggVec4 const *color, int column_n)
 	ImGui::TableSetBgColor(target, col, column_n);
 }
 
+void iggTableSetColumnEnabled(int column_n, IggBool enabled)
+{
+	ImGui::TableSetColumnEnabled(column_n, enabled != 0);
+}
+
 IggTableSortSpecs iggTableGetSortSpecs()
 {
 	return static_cast<IggTableSortSpecs>(ImGui::TableGetSortSpecs());
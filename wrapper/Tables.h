@@ -29,6 +29,7 @@ extern int         iggTableGetColumnCount();
 extern char const *iggTableGetColumnName(int column_n);
 extern int         iggTableGetColumnFlags(int column_n);
 extern void        iggTableSetBgColor(int target, IggVec4 const *color, int column_n);
+extern void        iggTableSetColumnEnabled(int column_n, IggBool enabled);
 
 extern IggTableSortSpecs iggTableGetSortSpecs();
 extern void iggTableSortSpecsGetSpec(IggTableSortSpecs handle, int index, IggTableColumnSortSpecs *out);
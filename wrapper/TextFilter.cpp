@@ -0,0 +1,52 @@
+#include "ConfiguredImGui.h"
+
+#include "TextFilter.h"
+
+IggTextFilter iggNewTextFilter(char const *defaultFilter)
+{
+   ImGuiTextFilter *filter = new ImGuiTextFilter(defaultFilter);
+   return static_cast<IggTextFilter>(filter);
+}
+
+void iggTextFilterDelete(IggTextFilter handle)
+{
+   ImGuiTextFilter *filter = reinterpret_cast<ImGuiTextFilter *>(handle);
+   delete filter;
+}
+
+IggBool iggTextFilterDraw(IggTextFilter handle, char const *label, float width)
+{
+   ImGuiTextFilter *filter = reinterpret_cast<ImGuiTextFilter *>(handle);
+   return filter->Draw(label, width) ? 1 : 0;
+}
+
+IggBool iggTextFilterPassFilter(IggTextFilter handle, char const *text)
+{
+   ImGuiTextFilter *filter = reinterpret_cast<ImGuiTextFilter *>(handle);
+   return filter->PassFilter(text) ? 1 : 0;
+}
+
+IggBool iggTextFilterIsActive(IggTextFilter handle)
+{
+   ImGuiTextFilter *filter = reinterpret_cast<ImGuiTextFilter *>(handle);
+   return filter->IsActive() ? 1 : 0;
+}
+
+void iggTextFilterClear(IggTextFilter handle)
+{
+   ImGuiTextFilter *filter = reinterpret_cast<ImGuiTextFilter *>(handle);
+   filter->Clear();
+}
+
+char const *iggTextFilterText(IggTextFilter handle)
+{
+   ImGuiTextFilter *filter = reinterpret_cast<ImGuiTextFilter *>(handle);
+   return filter->InputBuf;
+}
+
+void iggTextFilterSetText(IggTextFilter handle, char const *text)
+{
+   ImGuiTextFilter *filter = reinterpret_cast<ImGuiTextFilter *>(handle);
+   ImStrncpy(filter->InputBuf, text, IM_ARRAYSIZE(filter->InputBuf));
+   filter->Build();
+}
@@ -0,0 +1,22 @@
+#pragma once
+
+#include "Types.h"
+
+#ifdef __cplusplus
+extern "C"
+{
+#endif
+
+extern IggTextFilter iggNewTextFilter(char const *defaultFilter);
+extern void iggTextFilterDelete(IggTextFilter handle);
+
+extern IggBool iggTextFilterDraw(IggTextFilter handle, char const *label, float width);
+extern IggBool iggTextFilterPassFilter(IggTextFilter handle, char const *text);
+extern IggBool iggTextFilterIsActive(IggTextFilter handle);
+extern void iggTextFilterClear(IggTextFilter handle);
+extern char const *iggTextFilterText(IggTextFilter handle);
+extern void iggTextFilterSetText(IggTextFilter handle, char const *text);
+
+#ifdef __cplusplus
+}
+#endif
@@ -24,6 +24,7 @@ typedef void *IggFontGlyphRangesBuilder;
 typedef unsigned int IggPackedColor;
 typedef void *IggPayload;
 typedef void *IggTableSortSpecs;
+typedef void *IggTextFilter;
 typedef void *IggViewport;
 
 typedef struct tagIggVec2
@@ -127,6 +127,11 @@ IggBool iggDragIntRange2V(char const *label, int *currentMin, int *currentMax, f
    return ImGui::DragIntRange2(label, currentMin, currentMax, speed, min, max, format, formatMax, flags) ? 1 : 0;
 }
 
+IggBool iggDragScalarN(char const *label, int dataType, void *data, int components, float speed, void const *min, void const *max, char const *format, int flags)
+{
+   return ImGui::DragScalarN(label, dataType, data, components, speed, min, max, format, flags) ? 1 : 0;
+}
+
 IggBool iggSliderFloat(char const *label, float *value, float minValue, float maxValue, char const *format, int flags)
 {
    return ImGui::SliderFloat(label, value, minValue, maxValue, format, flags) ? 1 : 0;
@@ -147,6 +152,11 @@ IggBool iggSliderIntN(char const *label, int *value, int n, int minValue, int ma
    return ImGui::SliderScalarN(label, ImGuiDataType_S32, (void *)value, n, &minValue, &maxValue, format, flags) ? 1 : 0;
 }
 
+IggBool iggSliderScalarN(char const *label, int dataType, void *data, int components, void const *min, void const *max, char const *format, int flags)
+{
+   return ImGui::SliderScalarN(label, dataType, data, components, min, max, format, flags) ? 1 : 0;
+}
+
 IggBool iggVSliderFloat(char const *label, IggVec2 const *size, float *value, float minValue, float maxValue, char const *format, int flags)
 {
    Vec2Wrapper sizeArg(size);
@@ -193,6 +203,11 @@ IggBool iggInputFloat(char const *label, float* v, float step, float step_fast,
   return ImGui::InputFloat(label, v, step, step_fast, format, flags) ? 1 : 0;
 }
 
+IggBool iggInputScalarN(char const *label, int dataType, void *data, int components, void const *step, void const *stepFast, char const *format, int flags)
+{
+   return ImGui::InputScalarN(label, dataType, data, components, step, stepFast, format, flags) ? 1 : 0;
+}
+
 IggBool iggColorEdit3(char const *label, float *col, int flags)
 {
    return ImGui::ColorEdit3(label, col, flags) ? 1 : 0;
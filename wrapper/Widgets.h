@@ -35,12 +35,14 @@ extern IggBool iggDragFloatRange2V(char const *label, float *currentMin, float *
 extern IggBool iggDragInt(char const *label, int *value, float speed, int min, int max, char const *format, int flags);
 extern IggBool iggDragIntN(char const *label, int *value, int n, float speed, int min, int max, char const *format, int flags);
 extern IggBool iggDragIntRange2V(char const *label, int *currentMin, int *currentMax, float speed, int min, int max, char const *format, const char *formatMax, int flags);
+extern IggBool iggDragScalarN(char const *label, int dataType, void *data, int components, float speed, void const *min, void const *max, char const *format, int flags);
 
 extern IggBool iggSliderFloat(char const *label, float *value, float minValue, float maxValue, char const *format, int flags);
 extern IggBool iggSliderFloatN(char const *label, float *value, int n, float minValue, float maxValue, char const *format, int flags);
 
 extern IggBool iggSliderInt(char const *label, int *value, int minValue, int maxValue, char const *format, int flags);
 extern IggBool iggSliderIntN(char const *label, int *value, int n, int minValue, int maxValue, char const *format, int flags);
+extern IggBool iggSliderScalarN(char const *label, int dataType, void *data, int components, void const *min, void const *max, char const *format, int flags);
 
 extern IggBool iggVSliderFloat(char const *label, IggVec2 const *size, float *value, float minValue, float maxValue, char const *format, int flags);
 extern IggBool iggVSliderInt(char const *label, IggVec2 const *size, int *value, int minValue, int maxValue, char const *format, int flags);
@@ -51,6 +53,7 @@ extern IggBool iggInputTextMultiline(char const *label, char *buf, unsigned int
 extern IggBool iggInputInt(char const *label, int *value, int step, int step_fast, int flags);
 extern IggBool iggInputFloat(char const *label, float *v, float step,
                              float step_fast, const char *format, int flags);
+extern IggBool iggInputScalarN(char const *label, int dataType, void *data, int components, void const *step, void const *stepFast, char const *format, int flags);
 
 extern IggBool iggColorEdit3(char const *label, float *col, int flags);
 extern IggBool iggColorEdit4(char const *label, float *col, int flags);
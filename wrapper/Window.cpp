@@ -1,4 +1,5 @@
 #include "ConfiguredImGui.h"
+#include "imgui_internal.h"
 
 #include "Window.h"
 #include "WrapperConverter.h"
@@ -166,6 +167,16 @@ void iggPopTextWrapPos(void)
    ImGui::PopTextWrapPos();
 }
 
+float iggTextWrapWidth(void)
+{
+   ImGuiWindow *window = ImGui::GetCurrentWindowRead();
+   if (window->DC.TextWrapPos < 0.0f)
+   {
+      return -1.0f;
+   }
+   return ImGui::CalcWrapWidthForPos(window->DC.CursorPos, window->DC.TextWrapPos);
+}
+
 void iggPushButtonRepeat(IggBool repeat)
 {
    ImGui::PushButtonRepeat(repeat);
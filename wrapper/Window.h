@@ -40,6 +40,7 @@ extern void iggPopItemFlag(void);
 extern float iggCalcItemWidth(void);
 extern void iggPushTextWrapPos(float wrapPosX);
 extern void iggPopTextWrapPos(void);
+extern float iggTextWrapWidth(void);
 extern void iggPushButtonRepeat(IggBool repeat);
 extern void iggPopButtonRepeat(void);
 